@@ -0,0 +1,82 @@
+package graph
+
+import "fmt"
+
+// AStar finds the cheapest path from start to goal using A* search guided by
+// the heuristic h, which estimates the remaining path weight to goal. For the
+// path to be the cheapest, h must never overestimate it. Undirected graph
+// edges are followed both ways.
+//
+// Returns path edges in travel order and the number of expanded vertices.
+//
+// Returns ErrNotExists if either of the vertices is not in the Graph or goal is
+// unreachable, and ErrNegativeWeight if any of the Graph's edges has a
+// negative weight.
+func AStar[K comparable, V any, W Number](g *GraphOf[K, V, W], start, goal *VertexOf[K, V, W], h func(*VertexOf[K, V, W]) W) ([]*EdgeOf[K, V, W], int, error) {
+	if err := g.checkVertices([]*VertexOf[K, V, W]{start, goal}); err != nil {
+		return nil, 0, err
+	}
+	for _, e := range g.edges {
+		if e.Weight < 0 {
+			return nil, 0, fmt.Errorf("edge %w: %s", ErrNegativeWeight, e)
+		}
+	}
+
+	p := newPaths(start)
+	closed := make(map[*VertexOf[K, V, W]]bool)
+	expanded := 0
+
+	open := &vertexHeap[K, V, W, W]{}
+	open.push(start, h(start))
+	for open.Len() > 0 {
+		v := open.pop().vertex
+		if closed[v] {
+			continue // Stale item
+		}
+		if v == goal {
+			return p.PathTo(goal), expanded, nil
+		}
+		closed[v] = true
+		expanded++
+
+		for _, e := range v.edges {
+			w := e.Other(v)
+			d := p.dist[v] + e.Weight
+			if cur, ok := p.dist[w]; ok && cur <= d {
+				continue
+			}
+			p.dist[w] = d
+			p.prev[w] = e
+			delete(closed, w) // Reopen, heuristic may be inconsistent
+			open.push(w, d+h(w))
+		}
+	}
+	return nil, expanded, fmt.Errorf("path %w: %s to %s", ErrNotExists, start, goal)
+}
+
+// AStarDebug is like AStar, but additionally verifies that the heuristic
+// never overestimated the remaining weight of the returned path.
+//
+// Returns ErrInadmissibleHeuristic along with the path if it did.
+func AStarDebug[K comparable, V any, W Number](g *GraphOf[K, V, W], start, goal *VertexOf[K, V, W], h func(*VertexOf[K, V, W]) W) ([]*EdgeOf[K, V, W], int, error) {
+	path, expanded, err := AStar(g, start, goal, h)
+	if err != nil {
+		return path, expanded, err
+	}
+
+	var remaining W
+	v := goal
+	for i := len(path); ; i-- {
+		if est := h(v); est > remaining {
+			return path, expanded, fmt.Errorf("%w: vertex %s estimated %v, actual %v",
+				ErrInadmissibleHeuristic, v, est, remaining)
+		}
+		if i == 0 {
+			break
+		}
+		e := path[i-1]
+		remaining += e.Weight
+		v = e.Other(v)
+	}
+	return path, expanded, nil
+}
@@ -0,0 +1,157 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAStar(t *testing.T) {
+	// newGrid creates an undirected w*h grid with unit weights, vertex value
+	// is y*w+x.
+	newGrid := func(w, h int) (*Graph, []*Vertex) {
+		g := NewUndirected()
+		v := make([]*Vertex, w*h)
+		for i := range v {
+			v[i] = NewVertex(i)
+		}
+		assert.NoError(t, g.AddVertices(v...))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				if x+1 < w {
+					assert.NoError(t, g.AddEdges(NewEdge(v[y*w+x], v[y*w+x+1], 1)))
+				}
+				if y+1 < h {
+					assert.NoError(t, g.AddEdges(NewEdge(v[y*w+x], v[(y+1)*w+x], 1)))
+				}
+			}
+		}
+		return g, v
+	}
+	manhattan := func(w int, goal *Vertex) func(*Vertex) float64 {
+		return func(v *Vertex) float64 {
+			dx := v.Value%w - goal.Value%w
+			dy := v.Value/w - goal.Value/w
+			return math.Abs(float64(dx)) + math.Abs(float64(dy))
+		}
+	}
+	zero := func(*Vertex) float64 { return 0 }
+
+	t.Run("should find cheapest path on grid", func(t *testing.T) {
+		g, v := newGrid(7, 7)
+		start, goal := v[21], v[27]
+
+		path, expanded, err := AStar(g, start, goal, manhattan(7, goal))
+		assert.NoError(t, err)
+		assert.Len(t, path, 6)
+		assert.Equal(t, 6, expanded)
+
+		// Heuristic guidance expands less than uninformed search
+		_, uninformed, err := AStar(g, start, goal, zero)
+		assert.NoError(t, err)
+		assert.Less(t, expanded, uninformed)
+	})
+
+	t.Run("should match dijkstra distance", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+
+		e01 := NewEdge(v0, v1, 1)
+		e13 := NewEdge(v1, v3, 5)
+		e02 := NewEdge(v0, v2, 2)
+		e23 := NewEdge(v2, v3, 2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e01, e13, e02, e23))
+
+		path, _, err := AStar(g, v0, v3, zero)
+		assert.NoError(t, err)
+		assert.Equal(t, []*Edge{e02, e23}, path)
+	})
+
+	t.Run("should return empty path when start is goal", func(t *testing.T) {
+		g, v := newGrid(2, 2)
+
+		path, expanded, err := AStar(g, v[0], v[0], zero)
+		assert.NoError(t, err)
+		assert.Empty(t, path)
+		assert.Equal(t, 0, expanded)
+	})
+
+	t.Run("should throw an error when goal is unreachable", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(NewEdge(v1, v0, 1)))
+
+		_, _, err := AStar(g, v0, v1, zero)
+		assert.ErrorIs(t, err, ErrNotExists)
+	})
+
+	t.Run("should throw an error on negative weights", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(NewEdge(v0, v1, -1)))
+
+		_, _, err := AStar(g, v0, v1, zero)
+		assert.ErrorIs(t, err, ErrNegativeWeight)
+	})
+}
+
+func TestAStarDebug(t *testing.T) {
+	v0 := NewVertex(0)
+	v1 := NewVertex(1)
+	v2 := NewVertex(2)
+
+	g := NewUndirected()
+	assert.NoError(t, g.AddEdges(
+		NewEdge(v0, v1, 1),
+		NewEdge(v1, v2, 1),
+	))
+
+	t.Run("should accept admissible heuristic", func(t *testing.T) {
+		h := func(v *Vertex) float64 {
+			return float64(2 - v.Value)
+		}
+
+		path, _, err := AStarDebug(g, v0, v2, h)
+		assert.NoError(t, err)
+		assert.Len(t, path, 2)
+	})
+
+	t.Run("should report overestimating heuristic", func(t *testing.T) {
+		h := func(v *Vertex) float64 {
+			if v == v1 {
+				return 10
+			}
+			return 0
+		}
+
+		path, _, err := AStarDebug(g, v0, v2, h)
+		assert.ErrorIs(t, err, ErrInadmissibleHeuristic)
+		assert.Len(t, path, 2)
+	})
+
+	t.Run("should find path in generic graph", func(t *testing.T) {
+		g := NewUndirectedOf[string, struct{}, uint8]()
+		for _, e := range []struct {
+			from, to string
+			weight   uint8
+		}{{"a", "b", 100}, {"b", "c", 100}, {"a", "c", 250}} {
+			_, err := g.AddEdgeByValue(e.from, e.to, e.weight)
+			assert.NoError(t, err)
+		}
+		a, _ := g.Vertex("a")
+		c, _ := g.Vertex("c")
+
+		path, _, err := AStarDebug(g, a, c, func(*VertexOf[string, struct{}, uint8]) uint8 { return 0 })
+		assert.NoError(t, err)
+		assert.Len(t, path, 2)
+	})
+}
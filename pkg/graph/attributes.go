@@ -0,0 +1,63 @@
+package graph
+
+// Attributes is a store of arbitrary key/value attributes, embedded into
+// vertices and edges.
+type Attributes struct {
+	attrs map[string]any
+}
+
+// Attributer is implemented by anything holding Attributes.
+type Attributer interface {
+	Attr(key string) (any, bool)
+}
+
+// Attr retrieves an attribute value.
+//
+// Reports false if there is no such attribute.
+func (a *Attributes) Attr(key string) (any, bool) {
+	value, ok := a.attrs[key]
+	return value, ok
+}
+
+// SetAttr sets an attribute value, replacing the previous one.
+func (a *Attributes) SetAttr(key string, value any) {
+	if a.attrs == nil {
+		a.attrs = make(map[string]any)
+	}
+	a.attrs[key] = value
+}
+
+// DeleteAttr deletes an attribute. Missing attributes are ignored.
+func (a *Attributes) DeleteAttr(key string) {
+	delete(a.attrs, key)
+}
+
+// Attrs retrieves a copy of all attributes.
+func (a *Attributes) Attrs() map[string]any {
+	attrs := make(map[string]any, len(a.attrs))
+	for k, v := range a.attrs {
+		attrs[k] = v
+	}
+	return attrs
+}
+
+// copyAttrs replaces all attributes with a shallow copy of the ones given.
+func (a *Attributes) copyAttrs(from *Attributes) {
+	a.attrs = nil
+	if len(from.attrs) > 0 {
+		a.attrs = from.Attrs()
+	}
+}
+
+// AttrAs retrieves an attribute value of type T.
+//
+// Reports false if there is no such attribute or it is of a different type.
+func AttrAs[T any](a Attributer, key string) (T, bool) {
+	value, ok := a.Attr(key)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	t, ok := value.(T)
+	return t, ok
+}
@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttributes(t *testing.T) {
+	t.Run("should set and get vertex attributes", func(t *testing.T) {
+		v := NewVertex(0)
+
+		_, ok := v.Attr("color")
+		assert.False(t, ok)
+		assert.Empty(t, v.Attrs())
+
+		v.SetAttr("color", "red")
+		v.SetAttr("rank", 2)
+
+		color, ok := v.Attr("color")
+		assert.True(t, ok)
+		assert.Equal(t, "red", color)
+		assert.Equal(t, map[string]any{"color": "red", "rank": 2}, v.Attrs())
+
+		v.SetAttr("color", "blue")
+		color, _ = v.Attr("color")
+		assert.Equal(t, "blue", color)
+
+		v.DeleteAttr("color")
+		v.DeleteAttr("missing")
+		assert.Equal(t, map[string]any{"rank": 2}, v.Attrs())
+	})
+
+	t.Run("should set and get edge attributes", func(t *testing.T) {
+		e := NewEdge(NewVertex(0), NewVertex(1), 0)
+
+		e.SetAttr("capacity", 10.5)
+
+		capacity, ok := e.Attr("capacity")
+		assert.True(t, ok)
+		assert.Equal(t, 10.5, capacity)
+	})
+
+	t.Run("should retrieve a copy of attributes", func(t *testing.T) {
+		v := NewVertex(0)
+		v.SetAttr("label", "a")
+
+		attrs := v.Attrs()
+		attrs["label"] = "b"
+
+		label, _ := v.Attr("label")
+		assert.Equal(t, "a", label)
+	})
+
+	t.Run("should retrieve typed attributes", func(t *testing.T) {
+		v := NewVertex(0)
+		v.SetAttr("label", "a")
+
+		label, ok := AttrAs[string](v, "label")
+		assert.True(t, ok)
+		assert.Equal(t, "a", label)
+
+		n, ok := AttrAs[int](v, "label")
+		assert.False(t, ok)
+		assert.Equal(t, 0, n)
+
+		_, ok = AttrAs[string](v, "missing")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,290 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// BatchError aggregates all errors which caused a batch to be rolled back.
+//
+// It matches any of the aggregated errors with errors.Is and errors.As.
+type BatchError struct {
+	Errs []error
+}
+
+// Error retrieves semicolon separated messages of all errors.
+func (e *BatchError) Error() string {
+	s := make([]string, len(e.Errs))
+	for i, err := range e.Errs {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "; ")
+}
+
+// Is reports whether any of the errors matches target.
+func (e *BatchError) Is(target error) bool {
+	for _, err := range e.Errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error which matches target.
+func (e *BatchError) As(target any) bool {
+	for _, err := range e.Errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Tx is a batch of Graph modifications.
+type Tx = TxOf[int, struct{}, float64]
+
+// TxOf is a batch of GraphOf modifications, see GraphOf.Batch.
+//
+// Failed operations leave the Graph intact and are recorded, so the batch is
+// rolled back. Remaining operations are still attempted to report all errors.
+type TxOf[K comparable, V any, W Number] struct {
+	g        *GraphOf[K, V, W]
+	undo     []func()
+	errs     []error
+	returned []error
+}
+
+// Batch runs fn applying all of its Graph modifications atomically: if any of
+// the tx operations fails or fn returns an error, all of the modifications
+// are rolled back.
+//
+// Returns *BatchError aggregating errors of every failed operation and the
+// error returned by fn.
+func (g *GraphOf[K, V, W]) Batch(fn func(tx *TxOf[K, V, W]) error) error {
+	tx := &TxOf[K, V, W]{g: g}
+	if err := fn(tx); err != nil && !tx.isReturned(err) {
+		tx.errs = append(tx.errs, err)
+	}
+	if len(tx.errs) == 0 {
+		return nil
+	}
+
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		tx.undo[i]()
+	}
+	return &BatchError{Errs: tx.errs}
+}
+
+// Graph retrieves the Graph being modified.
+func (tx *TxOf[K, V, W]) Graph() *GraphOf[K, V, W] {
+	return tx.g
+}
+
+// AddVertices adds vertices to Graph, see GraphOf.AddVertices.
+func (tx *TxOf[K, V, W]) AddVertices(v ...*VertexOf[K, V, W]) error {
+	var errs []error
+	for _, v := range v {
+		if err := tx.addVertex(v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return tx.fail(errs...)
+}
+
+// AddEdges adds edges to Graph, see GraphOf.AddEdges.
+func (tx *TxOf[K, V, W]) AddEdges(e ...*EdgeOf[K, V, W]) error {
+	var errs []error
+	for _, e := range e {
+		if err := tx.addEdge(e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return tx.fail(errs...)
+}
+
+// AddEdgeByValue adds an edge between vertices of the values given, see
+// GraphOf.AddEdgeByValue.
+func (tx *TxOf[K, V, W]) AddEdgeByValue(from, to K, weight W) (*EdgeOf[K, V, W], error) {
+	start, ok := tx.g.byValue[from]
+	if !ok {
+		start = &VertexOf[K, V, W]{Value: from}
+	}
+	end := start
+	if to != from {
+		if end, ok = tx.g.byValue[to]; !ok {
+			end = &VertexOf[K, V, W]{Value: to}
+		}
+	}
+
+	e := NewEdgeOf(start, end, weight)
+	if err := tx.addEdge(e); err != nil {
+		return nil, tx.fail(err)
+	}
+	return e, nil
+}
+
+// DeleteEdge deletes an edge from Graph, see GraphOf.DeleteEdge.
+func (tx *TxOf[K, V, W]) DeleteEdge(e *EdgeOf[K, V, W]) error {
+	if !tx.g.edgeExists(e) {
+		return tx.fail(fmt.Errorf("edge %w: %s", ErrNotExists, e))
+	}
+	tx.saveEdges(&tx.g.edges)
+	tx.saveVertex(e.start)
+	tx.saveVertex(e.end)
+	return tx.fail(tx.g.DeleteEdge(e))
+}
+
+// DeleteVertices deletes vertices from Graph, see GraphOf.DeleteVertices.
+func (tx *TxOf[K, V, W]) DeleteVertices(v ...*VertexOf[K, V, W]) error {
+	if err := tx.g.checkVertices(v); err != nil {
+		return tx.fail(err)
+	}
+
+	tx.saveEdges(&tx.g.edges)
+	vertices := append([]*VertexOf[K, V, W](nil), tx.g.vertices...)
+	byValue := make(map[K]*VertexOf[K, V, W], len(v))
+	for _, v := range v {
+		byValue[v.Value] = v
+		tx.saveVertex(v)
+		for _, e := range v.edges {
+			tx.saveVertex(e.Other(v))
+		}
+		for _, e := range v.in {
+			tx.saveVertex(e.Other(v))
+		}
+	}
+	tx.undo = append(tx.undo, func() {
+		tx.g.vertices = vertices
+		for value, v := range byValue {
+			tx.g.byValue[value] = v
+		}
+	})
+	return tx.fail(tx.g.DeleteVertices(v...))
+}
+
+func (tx *TxOf[K, V, W]) addVertex(v *VertexOf[K, V, W]) error {
+	g := tx.g
+	if _, ok := g.byValue[v.Value]; ok {
+		return fmt.Errorf("vertex %w: %v", ErrExists, v.Value)
+	}
+
+	if g.byValue == nil { // Zero value Graph
+		g.byValue = make(map[K]*VertexOf[K, V, W])
+	}
+	n := len(g.vertices)
+	g.vertices = append(g.vertices, v)
+	g.byValue[v.Value] = v
+	tx.undo = append(tx.undo, func() {
+		g.vertices = g.vertices[:n]
+		delete(g.byValue, v.Value)
+	})
+	return nil
+}
+
+func (tx *TxOf[K, V, W]) addEdge(e *EdgeOf[K, V, W]) error {
+	g := tx.g
+
+	// Validate everything before modifying the Graph
+	// Edges in Graph are always in their start vertex edges, no need to scan
+	// all of the Graph edges.
+	if e.start.HasEdge(e) || (!g.directed && e.end.HasEdge(e)) {
+		return fmt.Errorf("edge %w: %s", ErrExists, e)
+	}
+	if !g.selfLoops && e.start == e.end {
+		return fmt.Errorf("edge %w: %s", ErrSelfLoop, e)
+	}
+	if !g.multiEdges && g.FindEdge(e.start, e.end) != nil {
+		return fmt.Errorf("edge %w: %s", ErrParallelEdge, e)
+	}
+	if e.start != e.end && e.start.Value == e.end.Value {
+		return fmt.Errorf("vertex %w: %v", ErrExists, e.end.Value)
+	}
+	for _, v := range []*VertexOf[K, V, W]{e.start, e.end} {
+		if w, ok := g.byValue[v.Value]; ok && w != v {
+			return fmt.Errorf("vertex %w: %v", ErrExists, v.Value)
+		}
+	}
+
+	// Ensure vertices exist
+	for _, v := range []*VertexOf[K, V, W]{e.start, e.end} {
+		if g.vertexExists(v) {
+			continue
+		}
+		if err := tx.addVertex(v); err != nil {
+			return err
+		}
+	}
+
+	// Add edge, recording a single undo for all of the slices
+	start, end := e.start, e.end
+	both := !g.directed && start != end // Undirected have edge both ways
+	nEdges, nStart, nEnd := len(g.edges), len(start.edges), len(end.in)
+	nStartIn, nEndOut := len(start.in), len(end.edges)
+
+	g.edges = append(g.edges, e)
+	start.appendEdge(e)
+	end.in = append(end.in, e)
+	if both {
+		end.appendEdge(e)
+		start.in = append(start.in, e)
+	}
+	tx.undo = append(tx.undo, func() {
+		g.edges = g.edges[:nEdges]
+		start.truncateEdges(nStart)
+		end.in = end.in[:nEnd]
+		if both {
+			end.truncateEdges(nEndOut)
+			start.in = start.in[:nStartIn]
+		}
+	})
+	return nil
+}
+
+// saveEdges records a copy of the slice to restore it on undo.
+func (tx *TxOf[K, V, W]) saveEdges(edges *[]*EdgeOf[K, V, W]) {
+	saved := append([]*EdgeOf[K, V, W](nil), *edges...)
+	tx.undo = append(tx.undo, func() {
+		*edges = saved
+	})
+}
+
+// saveVertex records copies of the Vertex edge slices to restore them on undo.
+func (tx *TxOf[K, V, W]) saveVertex(v *VertexOf[K, V, W]) {
+	edges := append([]*EdgeOf[K, V, W](nil), v.edges...)
+	tx.undo = append(tx.undo, func() {
+		v.setEdges(edges)
+	})
+	tx.saveEdges(&v.in)
+}
+
+// fail records errors of an operation, retrieving a single error to return to
+// the caller, nil if there are none.
+func (tx *TxOf[K, V, W]) fail(errs ...error) error {
+	var err error
+	switch {
+	case len(errs) == 0:
+		return nil
+	case len(errs) == 1:
+		if errs[0] == nil {
+			return nil
+		}
+		err = errs[0]
+	default:
+		err = &BatchError{Errs: errs}
+	}
+	tx.errs = append(tx.errs, errs...)
+	tx.returned = append(tx.returned, err)
+	return err
+}
+
+// isReturned reports whether the error was returned by one of the operations.
+func (tx *TxOf[K, V, W]) isReturned(err error) bool {
+	for _, r := range tx.returned {
+		if r == err {
+			return true
+		}
+	}
+	return false
+}
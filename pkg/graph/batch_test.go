@@ -0,0 +1,226 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_Batch(t *testing.T) {
+	t.Run("should apply all modifications", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 1)
+
+		g := NewDirected()
+		err := g.Batch(func(tx *Tx) error {
+			assert.NoError(t, tx.AddVertices(v2))
+			assert.NoError(t, tx.AddEdges(e01))
+			_, err := tx.AddEdgeByValue(1, 2, 2)
+			assert.NoError(t, err)
+			assert.Same(t, g, tx.Graph())
+			return nil
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "2 0 1", g.String())
+		assert.Len(t, g.GetEdges(), 2)
+		assert.Equal(t, []*Edge{e01}, v1.InEdges())
+	})
+
+	t.Run("should roll back all modifications on failure", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+
+		e01 := NewEdge(v0, v1, 1)
+		e12 := NewEdge(v1, v2, 2)
+		e23 := NewEdge(v2, v3, 3)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(e01, e12))
+		before, _ := g.Clone()
+
+		err := g.Batch(func(tx *Tx) error {
+			assert.NoError(t, tx.AddEdges(e23))
+			assert.NoError(t, tx.DeleteEdge(e01))
+			assert.NoError(t, tx.DeleteVertices(v1))
+			assert.ErrorIs(t, tx.AddVertices(NewVertex(0)), ErrExists)
+			return nil
+		})
+		assert.ErrorIs(t, err, ErrExists)
+
+		assert.True(t, before.Equal(g))
+		assert.Equal(t, "0 1 2", g.String())
+		assert.Equal(t, []*Edge{e01, e12}, g.GetEdges())
+		assert.Equal(t, []*Edge{e01}, v0.GetEdges())
+		assert.Equal(t, []*Edge{e01, e12}, v1.GetEdges())
+		assert.Equal(t, []*Edge{e01, e12}, v1.InEdges())
+		assert.Equal(t, []*Edge{e12}, v2.GetEdges())
+		assert.Empty(t, v3.GetEdges())
+
+		v, ok := g.Vertex(1)
+		assert.True(t, ok)
+		assert.Same(t, v1, v)
+		_, ok = g.Vertex(3)
+		assert.False(t, ok)
+	})
+
+	t.Run("should roll back edges of vertex with many edges", func(t *testing.T) {
+		g := NewUndirected()
+		for i := 1; i <= indexDegree; i++ {
+			_, err := g.AddEdgeByValue(0, i, 1)
+			assert.NoError(t, err)
+		}
+		hub, _ := g.Vertex(0)
+		v1, _ := g.Vertex(1)
+
+		extra := make([]*Edge, indexDegree)
+		for i := range extra {
+			extra[i] = NewEdge(hub, NewVertex(indexDegree+1+i), 1)
+		}
+		assert.ErrorIs(t, g.AddEdges(append(extra, NewEdge(v1, hub, 1))...), ErrParallelEdge)
+		assert.False(t, hub.HasEdge(extra[0]))
+		assert.False(t, hub.HasNeighbor(extra[0].End()))
+
+		err := g.Batch(func(tx *Tx) error {
+			assert.NoError(t, tx.AddEdges(extra...))
+			assert.NoError(t, tx.DeleteEdge(hub.FindEdge(v1)))
+			return errors.New("abort")
+		})
+		assert.Error(t, err)
+		assert.Len(t, hub.GetEdges(), indexDegree)
+		assert.True(t, hub.HasNeighbor(v1))
+		assert.False(t, hub.HasEdge(extra[0]))
+		assert.NoError(t, g.AddEdges(extra...))
+	})
+
+	t.Run("should roll back when fn returns an error", func(t *testing.T) {
+		errCustom := errors.New("custom")
+
+		g := NewDirected()
+		err := g.Batch(func(tx *Tx) error {
+			assert.NoError(t, tx.AddVertices(NewVertex(0)))
+			return errCustom
+		})
+
+		assert.ErrorIs(t, err, errCustom)
+		assert.Empty(t, g.GetVertices())
+	})
+
+	t.Run("should aggregate every error", func(t *testing.T) {
+		v0 := NewVertex(0)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddVertices(v0))
+
+		err := g.Batch(func(tx *Tx) error {
+			err := tx.AddEdges(
+				NewEdge(v0, v0, 0),
+				NewEdge(NewVertex(0), NewVertex(1), 0),
+			)
+			assert.Error(t, err)
+			_ = tx.DeleteEdge(NewEdge(v0, v0, 0))
+			return err
+		})
+
+		var batchErr *BatchError
+		assert.True(t, errors.As(err, &batchErr))
+		assert.Len(t, batchErr.Errs, 3)
+		assert.ErrorIs(t, batchErr.Errs[0], ErrSelfLoop)
+		assert.ErrorIs(t, batchErr.Errs[1], ErrExists)
+		assert.ErrorIs(t, batchErr.Errs[2], ErrNotExists)
+		assert.EqualError(t, err, "edge is a self-loop: 0 to 0; vertex already exists: 0; edge does not exist: 0 to 0")
+	})
+}
+
+func TestGraph_AddEdges_Atomic(t *testing.T) {
+	t.Run("should not add any edges nor vertices on failure", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 0)
+		e12 := NewEdge(v1, v2, 0)
+
+		g := NewUndirected()
+		err := g.AddEdges(e01, e12, NewEdge(v2, v0, 0), e01)
+		assert.ErrorIs(t, err, ErrExists)
+
+		assert.Empty(t, g.GetVertices())
+		assert.Empty(t, g.GetEdges())
+		assert.Empty(t, v1.GetEdges())
+		assert.Empty(t, v1.InEdges())
+	})
+
+	t.Run("should reject distinct endpoints with the same value", func(t *testing.T) {
+		g := NewDirected(AllowSelfLoops())
+
+		start := NewVertex(1)
+		end := NewVertex(1)
+		err := g.AddEdges(NewEdge(start, end, 0))
+		assert.ErrorIs(t, err, ErrExists)
+		assert.EqualError(t, err, "vertex already exists: 1")
+
+		assert.Empty(t, g.GetVertices())
+		assert.Empty(t, g.GetEdges())
+		assert.Empty(t, start.GetEdges())
+		assert.Empty(t, end.InEdges())
+	})
+
+	t.Run("should reject edge already attached to vertex", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		e01 := NewEdge(v0, v1, 0)
+		assert.NoError(t, v0.AddEdges(e01))
+
+		g := NewDirected()
+		assert.ErrorIs(t, g.AddEdges(e01), ErrExists)
+		assert.Empty(t, g.GetEdges())
+		assert.Empty(t, g.GetVertices())
+	})
+
+	t.Run("should not add any vertices on failure", func(t *testing.T) {
+		g := NewDirected()
+
+		err := g.AddVertices(NewVertex(0), NewVertex(1), NewVertex(0), NewVertex(1))
+
+		var batchErr *BatchError
+		assert.True(t, errors.As(err, &batchErr))
+		assert.Len(t, batchErr.Errs, 2)
+		assert.Empty(t, g.GetVertices())
+		_, ok := g.Vertex(0)
+		assert.False(t, ok)
+	})
+}
+
+func BenchmarkGraph_AddEdges(b *testing.B) {
+	const n = 10000
+
+	for i := 0; i < b.N; i++ {
+		g := NewDirected()
+		for j := 0; j < n; j++ {
+			if _, err := g.AddEdgeByValue(j, j+1, 1); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkGraph_AddEdges_hub(b *testing.B) {
+	const n = 10000
+
+	for i := 0; i < b.N; i++ {
+		g := NewUndirected()
+		for j := 1; j <= n; j++ {
+			if _, err := g.AddEdgeByValue(0, j, 1); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package graph
+
+import "fmt"
+
+// NegativeCycleError reports a negative cycle found in the Graph.
+type NegativeCycleError = NegativeCycleErrorOf[int, struct{}, float64]
+
+// NegativeCycleErrorOf reports a negative cycle found in the GraphOf.
+//
+// It matches ErrNegativeCycle with errors.Is.
+type NegativeCycleErrorOf[K comparable, V any, W Number] struct {
+	Cycle []*EdgeOf[K, V, W] // Cycle edges in travel order.
+}
+
+// Error retrieves a string representation of the cycle.
+func (e *NegativeCycleErrorOf[K, V, W]) Error() string {
+	return ErrNegativeCycle.Error() + ": " + edgesString(e.Cycle)
+}
+
+// Unwrap retrieves ErrNegativeCycle.
+func (e *NegativeCycleErrorOf[K, V, W]) Unwrap() error {
+	return ErrNegativeCycle
+}
+
+// BellmanFord finds shortest paths from source to every reachable Vertex using
+// Bellman-Ford algorithm. Unlike ShortestPaths, negative weights are allowed.
+// Undirected graph edges are followed both ways, so any negative undirected
+// edge forms a negative cycle.
+//
+// Returns ErrNotExists if source is not in the Graph and *NegativeCycleError
+// if a negative cycle is reachable from the source.
+func BellmanFord[K comparable, V any, W Number](g *GraphOf[K, V, W], source *VertexOf[K, V, W]) (*PathsOf[K, V, W], error) {
+	if !g.vertexExists(source) {
+		return nil, fmt.Errorf("vertex %w: %s", ErrNotExists, source)
+	}
+
+	p := newPaths(source)
+	if err := p.bellmanFord(g); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// bellmanFord relaxes Graph edges until distances settle, starting from the
+// distances already known.
+//
+// Returns *NegativeCycleError if distances don't settle after n-1 iterations.
+func (p *PathsOf[K, V, W]) bellmanFord(g *GraphOf[K, V, W]) error {
+	relax := func(from, to *VertexOf[K, V, W], e *EdgeOf[K, V, W]) bool {
+		d, ok := p.dist[from]
+		if !ok {
+			return false
+		}
+		d += e.Weight
+		if cur, ok := p.dist[to]; ok && cur <= d {
+			return false
+		}
+		p.dist[to] = d
+		p.prev[to] = e
+		return true
+	}
+	relaxAll := func() *VertexOf[K, V, W] {
+		var changed *VertexOf[K, V, W]
+		for _, e := range g.edges {
+			if relax(e.start, e.end, e) && changed == nil {
+				changed = e.end
+			}
+			if !g.directed && relax(e.end, e.start, e) && changed == nil {
+				changed = e.start
+			}
+		}
+		return changed
+	}
+
+	for i := 1; i < len(g.vertices); i++ {
+		if relaxAll() == nil {
+			return nil
+		}
+	}
+
+	changed := relaxAll()
+	if changed == nil {
+		return nil
+	}
+	return &NegativeCycleErrorOf[K, V, W]{Cycle: p.cycleFrom(changed, len(g.vertices))}
+}
+
+// cycleFrom extracts a cycle from the predecessor tree, starting from a Vertex
+// which was relaxed after n-1 iterations.
+func (p *PathsOf[K, V, W]) cycleFrom(v *VertexOf[K, V, W], n int) []*EdgeOf[K, V, W] {
+	// Walking back n times guarantees ending up on the cycle
+	for i := 0; i < n; i++ {
+		v = p.prev[v].Other(v)
+	}
+
+	var cycle []*EdgeOf[K, V, W]
+	for u := v; ; {
+		e := p.prev[u]
+		cycle = append(cycle, e)
+		u = e.Other(u)
+		if u == v {
+			break
+		}
+	}
+
+	// Reverse to travel order
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return cycle
+}
@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBellmanFord(t *testing.T) {
+	t.Run("should find shortest paths with negative weights", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+		v4 := NewVertex(4)
+
+		e01 := NewEdge(v0, v1, 4)
+		e02 := NewEdge(v0, v2, 5)
+		e21 := NewEdge(v2, v1, -3)
+		e13 := NewEdge(v1, v3, 2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e01, e02, e21, e13))
+		assert.NoError(t, g.AddVertices(v4))
+
+		p, err := BellmanFord(g, v0)
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(0), p.DistanceTo(v0))
+		assert.Equal(t, float64(2), p.DistanceTo(v1))
+		assert.Equal(t, float64(5), p.DistanceTo(v2))
+		assert.Equal(t, float64(4), p.DistanceTo(v3))
+		assert.Equal(t, math.MaxFloat64, p.DistanceTo(v4))
+		assert.Equal(t, []*Edge{e02, e21, e13}, p.PathTo(v3))
+	})
+
+	t.Run("should match dijkstra on non-negative weights", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 1),
+			NewEdge(v1, v2, 2),
+			NewEdge(v0, v2, 4),
+			NewEdge(v2, v3, 1),
+		))
+
+		want, err := ShortestPaths(g, v0)
+		assert.NoError(t, err)
+		got, err := BellmanFord(g, v0)
+		assert.NoError(t, err)
+
+		for _, v := range g.GetVertices() {
+			assert.Equal(t, want.DistanceTo(v), got.DistanceTo(v))
+		}
+	})
+
+	t.Run("should report reachable negative cycle", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+
+		e01 := NewEdge(v0, v1, 1)
+		e12 := NewEdge(v1, v2, 1)
+		e23 := NewEdge(v2, v3, -4)
+		e31 := NewEdge(v3, v1, 1)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e01, e12, e23, e31))
+
+		p, err := BellmanFord(g, v0)
+		assert.Nil(t, p)
+		assert.ErrorIs(t, err, ErrNegativeCycle)
+
+		var cycleErr *NegativeCycleError
+		assert.True(t, errors.As(err, &cycleErr))
+		assert.Len(t, cycleErr.Cycle, 3)
+		assert.ElementsMatch(t, []*Edge{e12, e23, e31}, cycleErr.Cycle)
+
+		// Edges are in travel order
+		for i, e := range cycleErr.Cycle {
+			next := cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]
+			assert.Equal(t, e.end, next.start)
+		}
+	})
+
+	t.Run("should ignore unreachable negative cycle", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v1, v2, -1),
+			NewEdge(v2, v1, -1),
+		))
+		assert.NoError(t, g.AddVertices(v0))
+
+		p, err := BellmanFord(g, v0)
+		assert.NoError(t, err)
+		assert.False(t, p.HasPathTo(v1))
+	})
+
+	t.Run("should report negative undirected edge as a cycle", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		e01 := NewEdge(v0, v1, -1)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(e01))
+
+		_, err := BellmanFord(g, v0)
+
+		var cycleErr *NegativeCycleError
+		assert.True(t, errors.As(err, &cycleErr))
+		assert.Equal(t, []*Edge{e01, e01}, cycleErr.Cycle)
+	})
+
+	t.Run("should throw an error when source is not in graph", func(t *testing.T) {
+		g := NewDirected()
+
+		_, err := BellmanFord(g, NewVertex(0))
+		assert.ErrorIs(t, err, ErrNotExists)
+	})
+
+	t.Run("should report negative cycle in generic graph", func(t *testing.T) {
+		g := NewDirectedOf[string, struct{}, int]()
+		_, err := g.AddEdgeByValue("a", "b", 1)
+		assert.NoError(t, err)
+		e, err := g.AddEdgeByValue("b", "a", -2)
+		assert.NoError(t, err)
+
+		a, _ := g.Vertex("a")
+		_, err = BellmanFord(g, a)
+
+		var cycleErr *NegativeCycleErrorOf[string, struct{}, int]
+		assert.True(t, errors.As(err, &cycleErr))
+		assert.Contains(t, cycleErr.Cycle, e)
+		assert.ErrorIs(t, err, ErrNegativeCycle)
+	})
+}
@@ -0,0 +1,98 @@
+package graph
+
+// CloneMap maps original vertices and edges to their copies.
+type CloneMap[K comparable, V any, W Number] struct {
+	Vertices map[*VertexOf[K, V, W]]*VertexOf[K, V, W]
+	Edges    map[*EdgeOf[K, V, W]]*EdgeOf[K, V, W]
+}
+
+// Clone creates an independent copy of the Graph with new vertices and edges.
+// Options are kept, values, data, weights and attributes are copied shallowly,
+// the order of vertices and edges is preserved.
+//
+// Returns the copy and a mapping from the original vertices and edges to the
+// new ones.
+func (g *GraphOf[K, V, W]) Clone() (*GraphOf[K, V, W], *CloneMap[K, V, W]) {
+	c := &GraphOf[K, V, W]{
+		options:  g.options,
+		directed: g.directed,
+		edges:    make([]*EdgeOf[K, V, W], 0, len(g.edges)),
+		vertices: make([]*VertexOf[K, V, W], 0, len(g.vertices)),
+		byValue:  make(map[K]*VertexOf[K, V, W], len(g.vertices)),
+	}
+	m := &CloneMap[K, V, W]{
+		Vertices: make(map[*VertexOf[K, V, W]]*VertexOf[K, V, W], len(g.vertices)),
+		Edges:    make(map[*EdgeOf[K, V, W]]*EdgeOf[K, V, W], len(g.edges)),
+	}
+
+	for _, v := range g.vertices {
+		w := &VertexOf[K, V, W]{Value: v.Value, Data: v.Data}
+		w.copyAttrs(&v.Attributes)
+		c.vertices = append(c.vertices, w)
+		c.byValue[w.Value] = w
+		m.Vertices[v] = w
+	}
+	for _, e := range g.edges {
+		f := NewEdgeOf(m.Vertices[e.start], m.Vertices[e.end], e.Weight)
+		f.copyAttrs(&e.Attributes)
+		c.edges = append(c.edges, f)
+		m.Edges[e] = f
+	}
+
+	// Copy vertices edge lists directly to keep their order
+	cloneEdges := func(edges []*EdgeOf[K, V, W]) []*EdgeOf[K, V, W] {
+		var cloned []*EdgeOf[K, V, W]
+		for _, e := range edges {
+			if f, ok := m.Edges[e]; ok {
+				cloned = append(cloned, f)
+			}
+		}
+		return cloned
+	}
+	for _, v := range g.vertices {
+		w := m.Vertices[v]
+		w.setEdges(cloneEdges(v.edges))
+		w.in = cloneEdges(v.in)
+	}
+	return c, m
+}
+
+// Equal reports whether both graphs are structurally the same: equally
+// directed, having the same vertex values and the same edges by their
+// endpoints values and weights, regardless of the insertion order.
+//
+// Vertices data and attributes are not compared.
+func (g *GraphOf[K, V, W]) Equal(other *GraphOf[K, V, W]) bool {
+	if g.directed != other.directed ||
+		len(g.vertices) != len(other.vertices) ||
+		len(g.edges) != len(other.edges) {
+		return false
+	}
+	for _, v := range g.vertices {
+		if _, ok := other.byValue[v.Value]; !ok {
+			return false
+		}
+	}
+
+	edges := make(map[edgeKey[K, W]]int, len(g.edges))
+	for _, e := range g.edges {
+		edges[edgeKey[K, W]{start: e.start.Value, end: e.end.Value, weight: e.Weight}]++
+	}
+	for _, e := range other.edges {
+		key := edgeKey[K, W]{start: e.start.Value, end: e.end.Value, weight: e.Weight}
+		if edges[key] == 0 && !g.directed {
+			key.start, key.end = key.end, key.start
+		}
+		if edges[key] == 0 {
+			return false
+		}
+		edges[key]--
+	}
+	return true
+}
+
+// edgeKey identifies an edge by its endpoints values and weight.
+type edgeKey[K comparable, W Number] struct {
+	start, end K
+	weight     W
+}
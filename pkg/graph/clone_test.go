@@ -0,0 +1,155 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_Clone(t *testing.T) {
+	t.Run("should create independent copy", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 1)
+		e12 := NewEdge(v1, v2, 2)
+
+		v0.SetAttr("label", "root")
+		e12.SetAttr("color", "red")
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e01, e12))
+
+		c, m := g.Clone()
+
+		assert.True(t, g.Equal(c))
+		assert.Equal(t, g.String(), c.String())
+		assert.Len(t, m.Vertices, 3)
+		assert.Len(t, m.Edges, 2)
+
+		for i, v := range g.GetVertices() {
+			w := c.GetVertices()[i]
+			assert.NotSame(t, v, w)
+			assert.Same(t, w, m.Vertices[v])
+			assert.Equal(t, v.Attrs(), w.Attrs())
+			assert.Equal(t, len(v.GetEdges()), len(w.GetEdges()))
+			assert.Equal(t, len(v.InEdges()), len(w.InEdges()))
+		}
+		for i, e := range g.GetEdges() {
+			f := c.GetEdges()[i]
+			assert.NotSame(t, e, f)
+			assert.Same(t, f, m.Edges[e])
+			assert.Same(t, m.Vertices[e.Start()], f.Start())
+			assert.Same(t, m.Vertices[e.End()], f.End())
+			assert.Equal(t, e.Attrs(), f.Attrs())
+		}
+
+		w1, ok := c.Vertex(1)
+		assert.True(t, ok)
+		assert.Same(t, m.Vertices[v1], w1)
+
+		// Modifying the copy leaves the original intact
+		assert.NoError(t, c.DeleteVertices(w1))
+		m.Vertices[v0].SetAttr("label", "changed")
+		m.Edges[e01].Weight = 10
+
+		assert.Equal(t, "0 1 2", g.String())
+		assert.Len(t, g.GetEdges(), 2)
+		assert.Equal(t, float64(1), e01.Weight)
+		assert.Equal(t, 1, v1.InDegree())
+		label, _ := v0.Attr("label")
+		assert.Equal(t, "root", label)
+	})
+
+	t.Run("should clone undirected graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(NewEdge(v0, v1, 1)))
+
+		c, m := g.Clone()
+
+		assert.True(t, g.Equal(c))
+		assert.Equal(t, c.GetEdges(), m.Vertices[v1].GetEdges())
+		assert.Equal(t, c.GetEdges(), m.Vertices[v1].InEdges())
+	})
+}
+
+func TestGraph_Equal(t *testing.T) {
+	newGraph := func(directed bool, edges ...[3]int) *Graph {
+		g := NewUndirected()
+		if directed {
+			g = NewDirected()
+		}
+		for _, e := range edges {
+			_, err := g.AddEdgeByValue(e[0], e[1], float64(e[2]))
+			assert.NoError(t, err)
+		}
+		return g
+	}
+
+	tests := []struct {
+		name string
+		a    *Graph
+		b    *Graph
+		want bool
+	}{
+		{
+			name: "empty graphs",
+			a:    NewDirected(),
+			b:    NewDirected(),
+			want: true,
+		},
+		{
+			name: "different directedness",
+			a:    NewDirected(),
+			b:    NewUndirected(),
+			want: false,
+		},
+		{
+			name: "different insertion order",
+			a:    newGraph(true, [3]int{0, 1, 1}, [3]int{1, 2, 2}),
+			b:    newGraph(true, [3]int{1, 2, 2}, [3]int{0, 1, 1}),
+			want: true,
+		},
+		{
+			name: "different weights",
+			a:    newGraph(true, [3]int{0, 1, 1}),
+			b:    newGraph(true, [3]int{0, 1, 2}),
+			want: false,
+		},
+		{
+			name: "reversed directed edge",
+			a:    newGraph(true, [3]int{0, 1, 1}),
+			b:    newGraph(true, [3]int{1, 0, 1}),
+			want: false,
+		},
+		{
+			name: "reversed undirected edge",
+			a:    newGraph(false, [3]int{0, 1, 1}, [3]int{1, 2, 1}),
+			b:    newGraph(false, [3]int{2, 1, 1}, [3]int{1, 0, 1}),
+			want: true,
+		},
+		{
+			name: "different vertex values",
+			a:    newGraph(true, [3]int{0, 1, 1}),
+			b:    newGraph(true, [3]int{0, 2, 1}),
+			want: false,
+		},
+		{
+			name: "same vertices different edges",
+			a:    newGraph(false, [3]int{0, 1, 1}, [3]int{1, 2, 1}),
+			b:    newGraph(false, [3]int{0, 1, 1}, [3]int{0, 2, 1}),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.a.Equal(tt.b))
+			assert.Equal(t, tt.want, tt.b.Equal(tt.a))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package graph
+
+import "github.com/sewiti/ktu-testing/pkg/unionfind"
+
+// ConnectedComponents finds connected components of the Graph. For directed
+// graphs edge directions are ignored, resulting in weakly connected
+// components.
+//
+// Components are ordered by their first Vertex in the Graph, and vertices
+// within a component keep the Graph's order.
+func ConnectedComponents[K comparable, V any, W Number](g *GraphOf[K, V, W]) [][]*VertexOf[K, V, W] {
+	sets := g.unionEdges()
+
+	var components [][]*VertexOf[K, V, W]
+	component := make(map[int]int) // Set representative to component index
+	for i, v := range g.vertices {
+		root := sets.Find(i)
+		c, ok := component[root]
+		if !ok {
+			c = len(components)
+			component[root] = c
+			components = append(components, nil)
+		}
+		components[c] = append(components[c], v)
+	}
+	return components
+}
+
+// IsConnected reports whether every Vertex is reachable from every other one,
+// ignoring edge directions. Graphs with no vertices are considered connected.
+func (g *GraphOf[K, V, W]) IsConnected() bool {
+	return g.unionEdges().Count() <= 1
+}
+
+// unionEdges creates a unionfind.UnionFind of Graph's vertices indices with
+// the sets merged along every edge.
+func (g *GraphOf[K, V, W]) unionEdges() *unionfind.UnionFind {
+	indices := g.GetVerticesIndices()
+	sets := unionfind.New(len(g.vertices))
+	for _, e := range g.edges {
+		sets.Union(indices[e.start], indices[e.end])
+	}
+	return sets
+}
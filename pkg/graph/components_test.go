@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectedComponents(t *testing.T) {
+	t.Run("should find connected components in undirected graph", func(t *testing.T) {
+		v := make([]*Vertex, 6)
+		for i := range v {
+			v[i] = NewVertex(i)
+		}
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddVertices(v...))
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v[0], v[3], 0),
+			NewEdge(v[3], v[4], 0),
+			NewEdge(v[1], v[2], 0),
+		))
+
+		expected := [][]*Vertex{
+			{v[0], v[3], v[4]},
+			{v[1], v[2]},
+			{v[5]},
+		}
+		assert.Equal(t, expected, ConnectedComponents(g))
+		assert.False(t, g.IsConnected())
+	})
+
+	t.Run("should find weakly connected components in directed graph", func(t *testing.T) {
+		v := make([]*Vertex, 4)
+		for i := range v {
+			v[i] = NewVertex(i)
+		}
+
+		g := NewDirected()
+		assert.NoError(t, g.AddVertices(v...))
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v[1], v[0], 0),
+			NewEdge(v[2], v[0], 0),
+		))
+
+		expected := [][]*Vertex{
+			{v[0], v[1], v[2]},
+			{v[3]},
+		}
+		assert.Equal(t, expected, ConnectedComponents(g))
+	})
+
+	t.Run("should return no components for empty graph", func(t *testing.T) {
+		assert.Empty(t, ConnectedComponents(NewUndirected()))
+	})
+
+	t.Run("should find components of service graph", func(t *testing.T) {
+		g := NewUndirectedOf[string, struct{}, int]()
+		_, err := g.AddEdgeByValue("api", "db", 1)
+		assert.NoError(t, err)
+		assert.NoError(t, g.AddVertices(NewVertexOf[string, struct{}, int]("cron", struct{}{})))
+
+		components := ConnectedComponents(g)
+		assert.Len(t, components, 2)
+		assert.Equal(t, "cron", components[1][0].Value)
+	})
+}
+
+func TestGraph_IsConnected(t *testing.T) {
+	t.Run("should report connected undirected graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v2, v1, 0),
+		))
+		assert.True(t, g.IsConnected())
+
+		assert.NoError(t, g.AddVertices(NewVertex(3)))
+		assert.False(t, g.IsConnected())
+	})
+
+	t.Run("should ignore edge directions in directed graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v2, v1, 0),
+		))
+		assert.True(t, g.IsConnected())
+	})
+
+	t.Run("should consider empty graph connected", func(t *testing.T) {
+		assert.True(t, NewDirected().IsConnected())
+	})
+}
@@ -0,0 +1,56 @@
+package graph
+
+import "fmt"
+
+// ShortestPaths finds shortest paths from source to every reachable Vertex
+// using Dijkstra's algorithm. Undirected graph edges are followed both ways.
+//
+// Returns ErrNotExists if source is not in the Graph and ErrNegativeWeight if
+// any of the Graph's edges has a negative weight.
+func ShortestPaths[K comparable, V any, W Number](g *GraphOf[K, V, W], source *VertexOf[K, V, W]) (*PathsOf[K, V, W], error) {
+	if !g.vertexExists(source) {
+		return nil, fmt.Errorf("vertex %w: %s", ErrNotExists, source)
+	}
+	for _, e := range g.edges {
+		if e.Weight < 0 {
+			return nil, fmt.Errorf("edge %w: %s", ErrNegativeWeight, e)
+		}
+	}
+
+	return dijkstra(g, source, func(e *EdgeOf[K, V, W], from *VertexOf[K, V, W]) W {
+		return e.Weight
+	}), nil
+}
+
+// dijkstra finds shortest paths from source using the weight function given,
+// which must not return negative values.
+func dijkstra[K comparable, V any, W Number](g *GraphOf[K, V, W], source *VertexOf[K, V, W], weight func(e *EdgeOf[K, V, W], from *VertexOf[K, V, W]) W) *PathsOf[K, V, W] {
+	p := newPaths(source)
+	done := make(map[*VertexOf[K, V, W]]bool, len(g.vertices))
+
+	h := &vertexHeap[K, V, W, W]{}
+	h.push(source, 0)
+	for h.Len() > 0 {
+		it := h.pop()
+		v := it.vertex
+		if done[v] {
+			continue // Stale item
+		}
+		done[v] = true
+
+		for _, e := range v.edges {
+			w := e.Other(v)
+			if done[w] {
+				continue
+			}
+			d := it.priority + weight(e, v)
+			if cur, ok := p.dist[w]; ok && cur <= d {
+				continue
+			}
+			p.dist[w] = d
+			p.prev[w] = e
+			h.push(w, d)
+		}
+	}
+	return p
+}
@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShortestPaths(t *testing.T) {
+	t.Run("should find shortest paths in directed graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+		v4 := NewVertex(4)
+
+		e01 := NewEdge(v0, v1, 4)
+		e02 := NewEdge(v0, v2, 1)
+		e21 := NewEdge(v2, v1, 2)
+		e13 := NewEdge(v1, v3, 1)
+		e23 := NewEdge(v2, v3, 5)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e01, e02, e21, e13, e23))
+		assert.NoError(t, g.AddVertices(v4))
+
+		p, err := ShortestPaths(g, v0)
+		assert.NoError(t, err)
+
+		assert.Equal(t, v0, p.Source())
+		assert.Equal(t, float64(0), p.DistanceTo(v0))
+		assert.Equal(t, float64(3), p.DistanceTo(v1))
+		assert.Equal(t, float64(1), p.DistanceTo(v2))
+		assert.Equal(t, float64(4), p.DistanceTo(v3))
+		assert.Equal(t, math.MaxFloat64, p.DistanceTo(v4))
+
+		assert.Equal(t, []*Edge{e02, e21, e13}, p.PathTo(v3))
+		assert.Equal(t, []*Edge{e02}, p.PathTo(v2))
+		assert.Nil(t, p.PathTo(v0))
+		assert.Nil(t, p.PathTo(v4))
+		assert.False(t, p.HasPathTo(v4))
+		assert.True(t, p.HasPathTo(v0))
+	})
+
+	t.Run("should not follow edges backwards in directed graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(NewEdge(v0, v1, 1)))
+
+		p, err := ShortestPaths(g, v1)
+		assert.NoError(t, err)
+		assert.False(t, p.HasPathTo(v0))
+	})
+
+	t.Run("should find shortest paths in undirected graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 7)
+		e12 := NewEdge(v1, v2, 2)
+		e20 := NewEdge(v2, v0, 3)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(e01, e12, e20))
+
+		p, err := ShortestPaths(g, v1)
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(5), p.DistanceTo(v0))
+		assert.Equal(t, []*Edge{e12, e20}, p.PathTo(v0))
+	})
+
+	t.Run("should throw an error on negative weights", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(NewEdge(v0, v1, -1)))
+
+		_, err := ShortestPaths(g, v0)
+		assert.ErrorIs(t, err, ErrNegativeWeight)
+	})
+
+	t.Run("should throw an error when source is not in graph", func(t *testing.T) {
+		g := NewDirected()
+
+		_, err := ShortestPaths(g, NewVertex(0))
+		assert.ErrorIs(t, err, ErrNotExists)
+	})
+
+	t.Run("should sum integer weights exactly", func(t *testing.T) {
+		g := NewDirectedOf[string, struct{}, int64]()
+		_, err := g.AddEdgeByValue("a", "b", 1<<53)
+		assert.NoError(t, err)
+		_, err = g.AddEdgeByValue("b", "c", 1)
+		assert.NoError(t, err)
+		d := NewVertexOf[string, struct{}, int64]("d", struct{}{})
+		assert.NoError(t, g.AddVertices(d))
+
+		a, _ := g.Vertex("a")
+		c, _ := g.Vertex("c")
+		p, err := ShortestPaths(g, a)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1<<53+1), p.DistanceTo(c))
+		assert.Len(t, p.PathTo(c), 2)
+		assert.Equal(t, int64(math.MaxInt64), p.DistanceTo(d))
+	})
+}
@@ -0,0 +1,732 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DOTIDAttr is the vertex attribute holding the original node ID of a DOT
+// node which is not an integer, see ReadDOT. Unlike Graphviz attributes, it
+// has to be quoted in DOT.
+const DOTIDAttr = "dot:id"
+
+// SyntaxError reports malformed input of a textual graph format.
+type SyntaxError struct {
+	Line int // Line number, starting from 1.
+	Msg  string
+}
+
+// Error retrieves the message prefixed with the line number.
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// DOTOptions configures WriteDOT.
+type DOTOptions struct {
+	// Name of the graph, omitted if empty.
+	Name string
+
+	// VertexAttrs retrieves additional attributes of a Vertex, overriding the
+	// ones stored in it.
+	VertexAttrs func(v *Vertex) map[string]string
+
+	// EdgeAttrs retrieves additional attributes of an Edge, overriding the
+	// ones stored in it and the weight label.
+	EdgeAttrs func(e *Edge) map[string]string
+}
+
+// WriteDOT writes Graph in the Graphviz DOT language: a digraph or a graph
+// depending on whether Graph is directed. Every Vertex is written as a node
+// identified by its value, edges are labeled with their weights. Vertices and
+// edges attributes are written formatted with fmt.Sprint.
+//
+// Options may be nil.
+func WriteDOT(w io.Writer, g *Graph, opts *DOTOptions) error {
+	if opts == nil {
+		opts = &DOTOptions{}
+	}
+
+	bw := bufio.NewWriter(w)
+	kind, op := "graph", "--"
+	if g.directed {
+		kind, op = "digraph", "->"
+	}
+	bw.WriteString(kind)
+	if opts.Name != "" {
+		bw.WriteString(" " + dotIdent(opts.Name))
+	}
+	bw.WriteString(" {\n")
+
+	for _, v := range g.vertices {
+		attrs := dotAttrs(&v.Attributes)
+		if opts.VertexAttrs != nil {
+			for k, value := range opts.VertexAttrs(v) {
+				attrs[k] = value
+			}
+		}
+		fmt.Fprintf(bw, "\t%d%s;\n", v.Value, dotAttrList(attrs))
+	}
+	for _, e := range g.edges {
+		attrs := dotAttrs(&e.Attributes)
+		attrs["label"] = strconv.FormatFloat(e.Weight, 'g', -1, 64)
+		if opts.EdgeAttrs != nil {
+			for k, value := range opts.EdgeAttrs(e) {
+				attrs[k] = value
+			}
+		}
+		fmt.Fprintf(bw, "\t%d %s %d%s;\n", e.start.Value, op, e.end.Value, dotAttrList(attrs))
+	}
+
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+// dotAttrs retrieves attributes formatted as strings.
+func dotAttrs(a *Attributes) map[string]string {
+	attrs := make(map[string]string, len(a.attrs)+1)
+	for k, value := range a.attrs {
+		attrs[k] = fmt.Sprint(value)
+	}
+	return attrs
+}
+
+// dotAttrList retrieves a DOT attribute list sorted by keys, empty if there
+// are no attributes.
+func dotAttrList(attrs map[string]string) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	list := make([]string, len(keys))
+	for i, k := range keys {
+		list[i] = dotIdent(k) + "=" + dotQuote(attrs[k])
+	}
+	return " [" + strings.Join(list, ", ") + "]"
+}
+
+// dotIdent retrieves an identifier as is if it is valid in DOT, otherwise
+// quoted.
+func dotIdent(s string) string {
+	switch strings.ToLower(s) {
+	case "", "strict", "graph", "digraph", "subgraph", "node", "edge":
+		return dotQuote(s)
+	}
+	if isDigit(s[0]) {
+		return dotQuote(s)
+	}
+	for i := 0; i < len(s); i++ {
+		if !isIDByte(s[i]) && !isDigit(s[i]) {
+			return dotQuote(s)
+		}
+	}
+	return s
+}
+
+// dotQuote retrieves a DOT double-quoted string.
+//
+// Only \" and a backslash followed by a newline, which is a line
+// continuation, are escapes in DOT, other backslashes are kept as is. A
+// backslash ending the string or followed by a newline is therefore followed
+// by a line continuation, so that it isn't read as an escape.
+func dotQuote(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '"':
+			sb.WriteString(`\"`)
+		case s[i] == '\\' && (i+1 == len(s) || s[i+1] == '\n'):
+			sb.WriteString("\\\\\n")
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// ReadDOT reads a Graph in the common subset of the Graphviz DOT language:
+// node and edge statements with attribute lists, default node and edge
+// attributes, and subgraphs, which are flattened into the Graph. Graph
+// attributes and ports are ignored.
+//
+// Nodes with integer IDs in canonical form, e.g. 1 but not 01, become
+// vertices with these values. Other nodes are
+// given the smallest unused values in the order of appearance, keeping their
+// IDs in the DOTIDAttr attribute. Numeric edge labels become weights, other
+// attributes are kept as strings.
+//
+// Graph allows self-loops, and multi-edges unless it is strict, in which case
+// attributes of repeated edges are merged.
+//
+// Returns *SyntaxError if the input is malformed, and ErrExists if a node
+// which is not an integer sets the DOTIDAttr attribute itself.
+func ReadDOT(r io.Reader) (*Graph, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := dotTokenize(string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &dotParser{tokens: tokens, nodes: make(map[string]*dotNode)}
+	if err := p.parseGraph(); err != nil {
+		return nil, err
+	}
+	return p.build()
+}
+
+type dotTokenKind int
+
+const (
+	dotEOF    dotTokenKind = iota
+	dotID                  // Identifier, numeral, quoted or HTML string.
+	dotPunct               // One of { } [ ] ; , = : + -> --
+	dotQuoted              // Double-quoted string, never a keyword.
+)
+
+type dotToken struct {
+	kind dotTokenKind
+	text string
+	line int
+}
+
+// dotTokenize splits DOT source into tokens, skipping whitespace, comments
+// and preprocessor lines.
+func dotTokenize(src string) ([]dotToken, error) {
+	var tokens []dotToken
+	line := 1
+	lineStart := true
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			lineStart = true
+			i++
+			continue
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+			continue
+		case c == '#' && lineStart:
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		}
+		lineStart = false
+
+		switch {
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, &SyntaxError{Line: line, Msg: "unterminated comment"}
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case strings.HasPrefix(src[i:], "->") || strings.HasPrefix(src[i:], "--"):
+			tokens = append(tokens, dotToken{kind: dotPunct, text: src[i : i+2], line: line})
+			i += 2
+		case strings.IndexByte("{}[];,=:+", c) >= 0:
+			tokens = append(tokens, dotToken{kind: dotPunct, text: string(c), line: line})
+			i++
+		case c == '"':
+			start := line
+			var sb strings.Builder
+			for i++; ; i++ {
+				if i >= len(src) {
+					return nil, &SyntaxError{Line: start, Msg: "unterminated string"}
+				}
+				if src[i] == '"' {
+					i++
+					break
+				}
+				if src[i] == '\\' && i+1 < len(src) {
+					switch src[i+1] {
+					case '"':
+						sb.WriteByte('"')
+						i++
+						continue
+					case '\n': // Line continuation
+						line++
+						i++
+						continue
+					}
+				}
+				if src[i] == '\n' {
+					line++
+				}
+				sb.WriteByte(src[i])
+			}
+			tokens = append(tokens, dotToken{kind: dotQuoted, text: sb.String(), line: start})
+		case c == '<':
+			start := line
+			depth := 0
+			j := i
+			for ; j < len(src); j++ {
+				if src[j] == '<' {
+					depth++
+				} else if src[j] == '>' {
+					depth--
+					if depth == 0 {
+						break
+					}
+				} else if src[j] == '\n' {
+					line++
+				}
+			}
+			if j >= len(src) {
+				return nil, &SyntaxError{Line: start, Msg: "unterminated HTML string"}
+			}
+			tokens = append(tokens, dotToken{kind: dotQuoted, text: src[i+1 : j], line: start})
+			i = j + 1
+		case c == '-' || c == '.' || isDigit(c):
+			j := i + 1
+			for j < len(src) && (isDigit(src[j]) || src[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, dotToken{kind: dotID, text: src[i:j], line: line})
+			i = j
+		case isIDByte(c):
+			j := i + 1
+			for j < len(src) && (isIDByte(src[j]) || isDigit(src[j])) {
+				j++
+			}
+			tokens = append(tokens, dotToken{kind: dotID, text: src[i:j], line: line})
+			i = j
+		default:
+			return nil, &SyntaxError{Line: line, Msg: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	return append(tokens, dotToken{kind: dotEOF, line: line}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIDByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+type dotNode struct {
+	id    string
+	attrs map[string]string
+}
+
+type dotEdge struct {
+	from, to string
+	attrs    map[string]string
+}
+
+// dotScope holds default attributes of a graph or a subgraph.
+type dotScope struct {
+	node, edge map[string]string
+}
+
+type dotParser struct {
+	tokens   []dotToken
+	pos      int
+	strict   bool
+	directed bool
+	nodes    map[string]*dotNode
+	order    []*dotNode
+	edges    []*dotEdge
+}
+
+func (p *dotParser) peek() dotToken {
+	return p.tokens[p.pos]
+}
+
+func (p *dotParser) next() dotToken {
+	t := p.tokens[p.pos]
+	if t.kind != dotEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the punctuation token if it is next.
+func (p *dotParser) accept(punct string) bool {
+	if t := p.peek(); t.kind == dotPunct && t.text == punct {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// keyword reports whether the token is the unquoted keyword.
+func (t dotToken) keyword(kw string) bool {
+	return t.kind == dotID && strings.EqualFold(t.text, kw)
+}
+
+func (t dotToken) isID() bool {
+	return t.kind == dotID || t.kind == dotQuoted
+}
+
+func (p *dotParser) errorf(t dotToken, format string, a ...any) error {
+	return &SyntaxError{Line: t.line, Msg: fmt.Sprintf(format, a...)}
+}
+
+func (p *dotParser) expect(punct string) error {
+	if !p.accept(punct) {
+		t := p.peek()
+		return p.errorf(t, "expected %q, found %s", punct, t.describe())
+	}
+	return nil
+}
+
+// describe retrieves a description of the token for error messages.
+func (t dotToken) describe() string {
+	if t.kind == dotEOF {
+		return "end of input"
+	}
+	return strconv.Quote(t.text)
+}
+
+// parseID parses an ID, concatenating quoted strings joined with '+'.
+func (p *dotParser) parseID() (string, error) {
+	t := p.next()
+	if !t.isID() {
+		return "", p.errorf(t, "expected ID, found %s", t.describe())
+	}
+	id := t.text
+	for t.kind == dotQuoted && p.accept("+") {
+		if t = p.next(); t.kind != dotQuoted {
+			return "", p.errorf(t, "expected string, found %s", t.describe())
+		}
+		id += t.text
+	}
+	return id, nil
+}
+
+func (p *dotParser) parseGraph() error {
+	if p.peek().keyword("strict") {
+		p.next()
+		p.strict = true
+	}
+	switch t := p.next(); {
+	case t.keyword("graph"):
+	case t.keyword("digraph"):
+		p.directed = true
+	default:
+		return p.errorf(t, "expected graph or digraph, found %s", t.describe())
+	}
+	if p.peek().isID() {
+		if _, err := p.parseID(); err != nil {
+			return err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	scope := &dotScope{node: map[string]string{}, edge: map[string]string{}}
+	if _, err := p.parseStmts(scope); err != nil {
+		return err
+	}
+	if t := p.next(); t.kind != dotEOF {
+		return p.errorf(t, "unexpected %s after graph", t.describe())
+	}
+	return nil
+}
+
+// parseStmts parses statements up to the closing brace, retrieving IDs of all
+// nodes mentioned in them.
+func (p *dotParser) parseStmts(scope *dotScope) ([]string, error) {
+	var ids []string
+	for !p.accept("}") {
+		t := p.peek()
+		var stmtIDs []string
+		var err error
+		switch {
+		case t.kind == dotEOF:
+			return nil, p.errorf(t, "expected \"}\", found end of input")
+		case t.keyword("graph"), t.keyword("node"), t.keyword("edge"):
+			p.next()
+			var attrs map[string]string
+			if attrs, err = p.parseAttrLists(); err != nil {
+				return nil, err
+			}
+			switch {
+			case t.keyword("node"):
+				mergeAttrs(scope.node, attrs)
+			case t.keyword("edge"):
+				mergeAttrs(scope.edge, attrs)
+			}
+		default:
+			stmtIDs, err = p.parseStmt(scope)
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, stmtIDs...)
+		p.accept(";")
+	}
+	return ids, nil
+}
+
+// parseStmt parses a node, an edge, a subgraph or a graph attribute statement.
+func (p *dotParser) parseStmt(scope *dotScope) ([]string, error) {
+	// Graph attribute, e.g. rankdir=LR
+	if p.peek().isID() && p.tokens[p.pos+1].kind == dotPunct && p.tokens[p.pos+1].text == "=" {
+		p.next()
+		p.next()
+		_, err := p.parseID()
+		return nil, err
+	}
+
+	first, isNode, err := p.parseOperand(scope)
+	if err != nil {
+		return nil, err
+	}
+	ids := first
+
+	if t := p.peek(); t.kind == dotPunct && (t.text == "->" || t.text == "--") {
+		operands := [][]string{first}
+		for {
+			t := p.peek()
+			if t.kind != dotPunct || (t.text != "->" && t.text != "--") {
+				break
+			}
+			p.next()
+			if (t.text == "->") != p.directed {
+				return nil, p.errorf(t, "edge operator %s in %s", t.text, p.kind())
+			}
+			operand, _, err := p.parseOperand(scope)
+			if err != nil {
+				return nil, err
+			}
+			operands = append(operands, operand)
+			ids = append(ids, operand...)
+		}
+
+		attrs, err := p.parseAttrLists()
+		if err != nil {
+			return nil, err
+		}
+		for i := 1; i < len(operands); i++ {
+			for _, from := range operands[i-1] {
+				for _, to := range operands[i] {
+					p.addEdge(from, to, scope.edge, attrs)
+				}
+			}
+		}
+		return ids, nil
+	}
+
+	if isNode {
+		attrs, err := p.parseAttrLists()
+		if err != nil {
+			return nil, err
+		}
+		mergeAttrs(p.nodes[first[0]].attrs, attrs)
+	}
+	return ids, nil
+}
+
+// parseOperand parses a node ID or a subgraph, retrieving IDs of the nodes in
+// it. Reports whether it is a single node.
+func (p *dotParser) parseOperand(scope *dotScope) ([]string, bool, error) {
+	t := p.peek()
+	if t.keyword("subgraph") || (t.kind == dotPunct && t.text == "{") {
+		ids, err := p.parseSubgraph(scope)
+		return ids, false, err
+	}
+
+	id, err := p.parseID()
+	if err != nil {
+		return nil, false, err
+	}
+	// Ports are ignored
+	for i := 0; i < 2 && p.accept(":"); i++ {
+		if _, err := p.parseID(); err != nil {
+			return nil, false, err
+		}
+	}
+	p.addNode(id, scope.node)
+	return []string{id}, true, nil
+}
+
+func (p *dotParser) parseSubgraph(scope *dotScope) ([]string, error) {
+	if p.peek().keyword("subgraph") {
+		p.next()
+		if p.peek().isID() {
+			if _, err := p.parseID(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	inner := &dotScope{node: copyStrings(scope.node), edge: copyStrings(scope.edge)}
+	return p.parseStmts(inner)
+}
+
+// parseAttrLists parses zero or more attribute lists, e.g. [a=1, b=2][c=3].
+func (p *dotParser) parseAttrLists() (map[string]string, error) {
+	attrs := map[string]string{}
+	for p.accept("[") {
+		for !p.accept("]") {
+			key, err := p.parseID()
+			if err != nil {
+				return nil, err
+			}
+			value := "true"
+			if p.accept("=") {
+				if value, err = p.parseID(); err != nil {
+					return nil, err
+				}
+			}
+			attrs[key] = value
+			if !p.accept(",") {
+				p.accept(";")
+			}
+		}
+	}
+	return attrs, nil
+}
+
+func (p *dotParser) kind() string {
+	if p.directed {
+		return "digraph"
+	}
+	return "graph"
+}
+
+// addNode adds a node with the default attributes, unless it already exists.
+func (p *dotParser) addNode(id string, defaults map[string]string) {
+	if _, ok := p.nodes[id]; ok {
+		return
+	}
+	n := &dotNode{id: id, attrs: copyStrings(defaults)}
+	p.nodes[id] = n
+	p.order = append(p.order, n)
+}
+
+// addEdge adds an edge with the default and the given attributes. In strict
+// graphs attributes of a repeated edge are merged into the existing one.
+func (p *dotParser) addEdge(from, to string, defaults, attrs map[string]string) {
+	if p.strict {
+		for _, e := range p.edges {
+			if (e.from == from && e.to == to) || (!p.directed && e.from == to && e.to == from) {
+				mergeAttrs(e.attrs, attrs)
+				return
+			}
+		}
+	}
+	e := &dotEdge{from: from, to: to, attrs: copyStrings(defaults)}
+	mergeAttrs(e.attrs, attrs)
+	p.edges = append(p.edges, e)
+}
+
+// build creates the Graph of the parsed nodes and edges.
+func (p *dotParser) build() (*Graph, error) {
+	opts := []Option{AllowSelfLoops()}
+	if !p.strict {
+		opts = append(opts, AllowMultiEdges())
+	}
+	g := NewUndirected(opts...)
+	if p.directed {
+		g = NewDirected(opts...)
+	}
+
+	values := make(map[string]int, len(p.order))
+	used := make(map[int]bool, len(p.order))
+	for _, n := range p.order {
+		if value, ok := intID(n.id); ok {
+			values[n.id] = value
+			used[value] = true
+		}
+	}
+	next := 0
+	for _, n := range p.order {
+		if _, ok := values[n.id]; ok {
+			continue
+		}
+		for used[next] {
+			next++
+		}
+		values[n.id] = next
+		used[next] = true
+		if _, ok := n.attrs[DOTIDAttr]; ok {
+			return nil, fmt.Errorf("node %q: attribute %w: %q", n.id, ErrExists, DOTIDAttr)
+		}
+		n.attrs[DOTIDAttr] = n.id
+	}
+
+	vertices := make(map[string]*Vertex, len(p.order))
+	for _, n := range p.order {
+		v := NewVertex(values[n.id])
+		for k, value := range n.attrs {
+			v.SetAttr(k, value)
+		}
+		vertices[n.id] = v
+	}
+	if err := g.AddVertices(p.vertices(vertices)...); err != nil {
+		return nil, err
+	}
+
+	edges := make([]*Edge, len(p.edges))
+	for i, de := range p.edges {
+		e := NewEdge(vertices[de.from], vertices[de.to], 0)
+		for k, value := range de.attrs {
+			if k == "label" {
+				if weight, err := strconv.ParseFloat(value, 64); err == nil {
+					e.Weight = weight
+					continue
+				}
+			}
+			e.SetAttr(k, value)
+		}
+		edges[i] = e
+	}
+	if err := g.AddEdges(edges...); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// intID retrieves the integer value of the ID, reporting false unless it is
+// an integer in canonical form, so that IDs like 1 and 01 are different.
+func intID(id string) (int, bool) {
+	value, err := strconv.Atoi(id)
+	return value, err == nil && strconv.Itoa(value) == id
+}
+
+// vertices retrieves the vertices in the order of nodes appearance.
+func (p *dotParser) vertices(byID map[string]*Vertex) []*Vertex {
+	vertices := make([]*Vertex, len(p.order))
+	for i, n := range p.order {
+		vertices[i] = byID[n.id]
+	}
+	return vertices
+}
+
+func mergeAttrs(dst, src map[string]string) {
+	for k, v := range src {
+		dst[k] = v
+	}
+}
+
+func copyStrings(m map[string]string) map[string]string {
+	c := make(map[string]string, len(m))
+	mergeAttrs(c, m)
+	return c
+}
@@ -0,0 +1,257 @@
+package graph
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteDOT(t *testing.T) {
+	t.Run("should write digraph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 1.5)
+		v0.SetAttr("label", `say "hi"`)
+		e01.SetAttr("color", "red")
+
+		g := NewDirected()
+		assert.NoError(t, g.AddVertices(v2))
+		assert.NoError(t, g.AddEdges(e01, NewEdge(v1, v2, 2)))
+
+		var buf bytes.Buffer
+		assert.NoError(t, WriteDOT(&buf, g, &DOTOptions{Name: "G"}))
+		assert.Equal(t, `digraph G {
+	2;
+	0 [label="say \"hi\""];
+	1;
+	0 -> 1 [color="red", label="1.5"];
+	1 -> 2 [label="2"];
+}
+`, buf.String())
+	})
+
+	t.Run("should write graph with additional attributes", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		e01 := NewEdge(v0, v1, 3)
+		e01.SetAttr("weight", 5)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(e01))
+
+		var buf bytes.Buffer
+		assert.NoError(t, WriteDOT(&buf, g, &DOTOptions{
+			VertexAttrs: func(v *Vertex) map[string]string {
+				if v.Value == 1 {
+					return map[string]string{"shape": "box"}
+				}
+				return nil
+			},
+			EdgeAttrs: func(e *Edge) map[string]string {
+				return map[string]string{"label": "three"}
+			},
+		}))
+		assert.Equal(t, `graph {
+	0;
+	1 [shape="box"];
+	0 -- 1 [label="three", weight="5"];
+}
+`, buf.String())
+	})
+
+	t.Run("should write empty graph", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, WriteDOT(&buf, NewUndirected(), nil))
+		assert.Equal(t, "graph {\n}\n", buf.String())
+	})
+}
+
+func TestReadDOT(t *testing.T) {
+	t.Run("should round-trip graph", func(t *testing.T) {
+		g := NewUndirected(AllowMultiEdges(), AllowSelfLoops())
+		e01, err := g.AddEdgeByValue(0, 1, 1.5)
+		assert.NoError(t, err)
+		_, err = g.AddEdgeByValue(1, 0, -2)
+		assert.NoError(t, err)
+		_, err = g.AddEdgeByValue(2, 2, 3)
+		assert.NoError(t, err)
+		assert.NoError(t, g.AddVertices(NewVertex(7)))
+		e01.SetAttr("color", "red")
+		g.GetVertices()[0].SetAttr("label", `a "quoted" label`)
+
+		var buf bytes.Buffer
+		assert.NoError(t, WriteDOT(&buf, g, nil))
+
+		decoded, err := ReadDOT(&buf)
+		assert.NoError(t, err)
+		assert.True(t, g.Equal(decoded))
+		assert.Equal(t, g.String(), decoded.String())
+
+		color, _ := decoded.GetEdges()[0].Attr("color")
+		assert.Equal(t, "red", color)
+		_, ok := decoded.GetEdges()[0].Attr("label")
+		assert.False(t, ok)
+		label, _ := decoded.GetVertices()[0].Attr("label")
+		assert.Equal(t, `a "quoted" label`, label)
+	})
+
+	t.Run("should round-trip backslashes", func(t *testing.T) {
+		values := []string{`C:\dir\`, `\`, `a\"b`, "line\\\nnext", `\n`}
+
+		g := NewDirected()
+		for i, value := range values {
+			v := NewVertex(i)
+			v.SetAttr("label", value)
+			assert.NoError(t, g.AddVertices(v))
+		}
+
+		var buf bytes.Buffer
+		assert.NoError(t, WriteDOT(&buf, g, nil))
+		assert.Contains(t, buf.String(), "\t0 [label=\"C:\\dir\\\\\n\"];\n")
+
+		decoded, err := ReadDOT(&buf)
+		assert.NoError(t, err)
+		for i, value := range values {
+			v, _ := decoded.Vertex(i)
+			label, _ := v.Attr("label")
+			assert.Equal(t, value, label)
+		}
+	})
+
+	t.Run("should read hand-drawn digraph", func(t *testing.T) {
+		src := `/* Hand-drawn */
+strict digraph "deps" {
+	rankdir=LR; // Graph attributes are ignored
+	graph [splines=ortho]
+	node [shape=box]
+	a [label="A" color=red];
+	a -> b -> c [label=2];
+	a -> b [style=dashed];
+	subgraph cluster_x {
+		edge [color=blue]
+		c -> { d 3 };
+	}
+	5:n -> a:s;
+	"e" + "f";
+}
+`
+		g, err := ReadDOT(strings.NewReader(src))
+		assert.NoError(t, err)
+
+		// Integer IDs keep their values, others get unused ones
+		assert.Equal(t, "0 1 2 4 3 5 6", g.String())
+		var ids []any
+		for _, v := range g.GetVertices() {
+			id, _ := v.Attr(DOTIDAttr)
+			ids = append(ids, id)
+		}
+		assert.Equal(t, []any{"a", "b", "c", "d", nil, nil, "ef"}, ids)
+
+		a, _ := g.Vertex(0)
+		assert.Equal(t, map[string]any{"dot:id": "a", "label": "A", "color": "red", "shape": "box"}, a.Attrs())
+
+		edges := make([]string, len(g.GetEdges()))
+		for i, e := range g.GetEdges() {
+			edges[i] = e.String()
+		}
+		assert.Equal(t, []string{"0 to 1", "1 to 2", "2 to 4", "2 to 3", "5 to 0"}, edges)
+
+		// Strict graph merges repeated edges
+		ab := g.GetEdges()[0]
+		assert.Equal(t, float64(2), ab.Weight)
+		assert.Equal(t, map[string]any{"style": "dashed"}, ab.Attrs())
+
+		cd := g.GetEdges()[2]
+		assert.Equal(t, float64(0), cd.Weight)
+		assert.Equal(t, map[string]any{"color": "blue"}, cd.Attrs())
+		assert.Empty(t, g.GetEdges()[4].Attrs())
+	})
+
+	t.Run("should keep id attribute apart from node ID", func(t *testing.T) {
+		g, err := ReadDOT(strings.NewReader(`digraph { a [id="svg1"]; a -> b }`))
+		assert.NoError(t, err)
+
+		a, _ := g.Vertex(0)
+		assert.Equal(t, map[string]any{"id": "svg1", DOTIDAttr: "a"}, a.Attrs())
+		b, _ := g.Vertex(1)
+		assert.Equal(t, map[string]any{DOTIDAttr: "b"}, b.Attrs())
+	})
+
+	t.Run("should reject node setting its own ID attribute", func(t *testing.T) {
+		g, err := ReadDOT(strings.NewReader(`digraph { a ["dot:id"=b]; 1 ["dot:id"=c] }`))
+		assert.Nil(t, g)
+		assert.ErrorIs(t, err, ErrExists)
+
+		g, err = ReadDOT(strings.NewReader(`digraph { 1 ["dot:id"=c] }`))
+		assert.NoError(t, err)
+		v1, _ := g.Vertex(1)
+		id, _ := v1.Attr(DOTIDAttr)
+		assert.Equal(t, "c", id)
+	})
+
+	t.Run("should not treat non-canonical integers as integer IDs", func(t *testing.T) {
+		g, err := ReadDOT(strings.NewReader(`digraph { 1 -> 01; 2 -> 3; "-0" }`))
+		assert.NoError(t, err)
+
+		assert.Equal(t, "1 0 2 3 4", g.String())
+		assert.Len(t, g.GetEdges(), 2)
+		for _, e := range g.GetEdges() {
+			assert.Same(t, e, e.End().InEdges()[0])
+		}
+
+		v0, _ := g.Vertex(0)
+		id, _ := v0.Attr(DOTIDAttr)
+		assert.Equal(t, "01", id)
+		v4, _ := g.Vertex(4)
+		id, _ = v4.Attr(DOTIDAttr)
+		assert.Equal(t, "-0", id)
+		v1, _ := g.Vertex(1)
+		_, ok := v1.Attr(DOTIDAttr)
+		assert.False(t, ok)
+	})
+
+	t.Run("should read undirected multigraph", func(t *testing.T) {
+		g, err := ReadDOT(strings.NewReader(`graph { 1 -- 2; 2 -- 1 [label=x]; 1 -- 1 }`))
+		assert.NoError(t, err)
+
+		v1, _ := g.Vertex(1)
+		v2, _ := g.Vertex(2)
+		assert.Len(t, g.FindEdges(v1, v2), 2)
+		assert.Len(t, g.GetEdges(), 3)
+		label, _ := g.GetEdges()[1].Attr("label")
+		assert.Equal(t, "x", label)
+	})
+
+	tests := []struct {
+		name string
+		src  string
+		line int
+	}{
+		{name: "missing graph keyword", src: `{ a }`, line: 1},
+		{name: "unterminated graph", src: "digraph {\n a -> b", line: 2},
+		{name: "undirected edge in digraph", src: "digraph {\n\n a -- b }", line: 3},
+		{name: "directed edge in graph", src: "graph { a -> b }", line: 1},
+		{name: "unterminated string", src: "graph {\n \"a }", line: 2},
+		{name: "unterminated comment", src: "graph { /* a }", line: 1},
+		{name: "unexpected character", src: "graph {\n a @ b }", line: 2},
+		{name: "missing attribute value", src: "graph {\n a [label=] }", line: 2},
+		{name: "trailing input", src: "graph { }\n}", line: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			g, err := ReadDOT(strings.NewReader(tt.src))
+			assert.Nil(t, g)
+
+			var syntaxErr *SyntaxError
+			if assert.True(t, errors.As(err, &syntaxErr)) {
+				assert.Equal(t, tt.line, syntaxErr.Line)
+			}
+		})
+	}
+}
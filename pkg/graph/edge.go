@@ -1,17 +1,31 @@
 package graph
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // Edge represents a weighted directional connection between two vertices.
-type Edge struct {
-	Weight float64
-	start  *Vertex
-	end    *Vertex
+type Edge = EdgeOf[int, struct{}, float64]
+
+// EdgeOf represents a weighted directional connection between two vertices of
+// a GraphOf.
+type EdgeOf[K comparable, V any, W Number] struct {
+	Attributes
+
+	Weight W
+	start  *VertexOf[K, V, W]
+	end    *VertexOf[K, V, W]
 }
 
 // NewEdge creates a new Edge. Vertices are unaffected.
 func NewEdge(start, end *Vertex, weight float64) *Edge {
-	return &Edge{
+	return NewEdgeOf(start, end, weight)
+}
+
+// NewEdgeOf creates a new EdgeOf. Vertices are unaffected.
+func NewEdgeOf[K comparable, V any, W Number](start, end *VertexOf[K, V, W], weight W) *EdgeOf[K, V, W] {
+	return &EdgeOf[K, V, W]{
 		Weight: weight,
 		start:  start,
 		end:    end,
@@ -19,11 +33,48 @@ func NewEdge(start, end *Vertex, weight float64) *Edge {
 }
 
 // Reverse reverses the Edge direction.
-func (e *Edge) Reverse() {
+func (e *EdgeOf[K, V, W]) Reverse() {
 	e.start, e.end = e.end, e.start
 }
 
 // String retrieves a string representation of the Edge.
-func (e *Edge) String() string {
+func (e *EdgeOf[K, V, W]) String() string {
 	return fmt.Sprintf("%s to %s", e.start.String(), e.end.String())
 }
+
+// Start retrieves the Vertex the Edge goes from.
+func (e *EdgeOf[K, V, W]) Start() *VertexOf[K, V, W] {
+	return e.start
+}
+
+// End retrieves the Vertex the Edge goes to.
+func (e *EdgeOf[K, V, W]) End() *VertexOf[K, V, W] {
+	return e.end
+}
+
+// Endpoints retrieves both Edge vertices: start and end.
+func (e *EdgeOf[K, V, W]) Endpoints() (*VertexOf[K, V, W], *VertexOf[K, V, W]) {
+	return e.start, e.end
+}
+
+// Other retrieves the Edge endpoint opposite to the Vertex given.
+//
+// Returns nil if the Vertex is not an endpoint.
+func (e *EdgeOf[K, V, W]) Other(v *VertexOf[K, V, W]) *VertexOf[K, V, W] {
+	switch v {
+	case e.start:
+		return e.end
+	case e.end:
+		return e.start
+	}
+	return nil
+}
+
+// edgesString retrieves comma separated string representations of the edges.
+func edgesString[K comparable, V any, W Number](edges []*EdgeOf[K, V, W]) string {
+	s := make([]string, len(edges))
+	for i, e := range edges {
+		s[i] = e.String()
+	}
+	return strings.Join(s, ", ")
+}
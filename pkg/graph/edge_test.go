@@ -47,3 +47,30 @@ func TestEdge(t *testing.T) {
 		assert.Equal(t, float64(6), e.Weight)
 	})
 }
+
+func TestEdge_Endpoints(t *testing.T) {
+	v0 := NewVertex(0)
+	v1 := NewVertex(1)
+	v2 := NewVertex(2)
+	e := NewEdge(v0, v1, 0)
+
+	t.Run("should retrieve edge endpoints", func(t *testing.T) {
+		assert.Equal(t, v0, e.Start())
+		assert.Equal(t, v1, e.End())
+
+		start, end := e.Endpoints()
+		assert.Equal(t, v0, start)
+		assert.Equal(t, v1, end)
+	})
+
+	t.Run("should retrieve opposite endpoint", func(t *testing.T) {
+		assert.Equal(t, v1, e.Other(v0))
+		assert.Equal(t, v0, e.Other(v1))
+		assert.Nil(t, e.Other(v2))
+	})
+
+	t.Run("should retrieve the same vertex for self-loop", func(t *testing.T) {
+		loop := NewEdge(v2, v2, 0)
+		assert.Equal(t, v2, loop.Other(v2))
+	})
+}
@@ -0,0 +1,302 @@
+// Package dimacs reads and writes graphs in the DIMACS challenge formats:
+// shortest path ("p sp"), maximum flow ("p max") and graph coloring
+// ("p edge").
+//
+// DIMACS vertices are numbered from 1 to n, they map onto Graph vertices of
+// the same values. Arc lengths and capacities map onto edge weights.
+package dimacs
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sewiti/ktu-testing/pkg/graph"
+)
+
+// Problem is a DIMACS problem type.
+type Problem string
+
+const (
+	// ShortestPath is a directed Graph with arcs "a u v length".
+	ShortestPath Problem = "sp"
+
+	// MaxFlow is a directed Graph with arcs "a u v capacity", a source
+	// "n s s" and a sink "n t t".
+	MaxFlow Problem = "max"
+
+	// Coloring is an undirected Graph with unweighted edges "e u v".
+	Coloring Problem = "edge"
+)
+
+// EdgeWeight is the weight of edges read from unweighted "e" lines.
+const EdgeWeight = 1
+
+// Instance is a DIMACS problem instance.
+type Instance struct {
+	Problem  Problem
+	Graph    *graph.Graph
+	Source   *graph.Vertex // Flow source, MaxFlow only.
+	Sink     *graph.Vertex // Flow sink, MaxFlow only.
+	Comments []string      // Text of "c" lines, written before the problem line.
+}
+
+// Read reads a DIMACS problem instance: the problem line "p <problem> n m"
+// followed by m arc or edge lines, and for MaxFlow the source and sink lines.
+// "c" lines are comments. The problem "col" is read as Coloring.
+//
+// Some Coloring instances list every edge in both directions and count both
+// in m, so an edge line "e v u" repeating an edge "e u v" is counted, but
+// not added again.
+//
+// Graph is created with the options given, having vertices with values from
+// 1 to n.
+//
+// Returns *graph.SyntaxError if a line is malformed, does not belong to the
+// problem, or the number of arcs or edges differs from m. Returns an error
+// prefixed with the line number if an endpoint is out of range, wrapping
+// graph.ErrNotExists, or if Graph rejects an edge, see graph.Graph.AddEdges.
+func Read(r io.Reader, opts ...graph.Option) (*Instance, error) {
+	inst := &Instance{}
+	var m, edges, line int
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line++
+		text := sc.Text()
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if fields[0] != "c" && fields[0] != "p" && inst.Graph == nil {
+			return nil, syntaxError(line, "expected problem line before %q", fields[0])
+		}
+
+		var err error
+		switch fields[0] {
+		case "c":
+			comment := strings.TrimPrefix(strings.TrimLeft(text, " \t"), "c")
+			inst.Comments = append(inst.Comments, strings.TrimPrefix(comment, " "))
+		case "p":
+			m, err = inst.problem(line, fields, opts)
+		case "n":
+			err = inst.node(line, fields)
+		case "a", "e":
+			edges++
+			err = inst.edge(line, fields)
+		default:
+			err = syntaxError(line, "unknown line type %q", fields[0])
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case inst.Graph == nil:
+		return nil, syntaxError(line, "missing problem line")
+	case edges != m:
+		return nil, syntaxError(line, "expected %d arcs or edges, found %d", m, edges)
+	case inst.Problem == MaxFlow && inst.Source == nil:
+		return nil, syntaxError(line, "missing source line")
+	case inst.Problem == MaxFlow && inst.Sink == nil:
+		return nil, syntaxError(line, "missing sink line")
+	}
+	return inst, nil
+}
+
+// problem parses the problem line, creating Graph with n vertices. Retrieves
+// the number of arcs or edges.
+func (inst *Instance) problem(line int, fields []string, opts []graph.Option) (int, error) {
+	if inst.Graph != nil {
+		return 0, syntaxError(line, "duplicate problem line")
+	}
+	if len(fields) != 4 {
+		return 0, syntaxError(line, "expected \"p <problem> n m\"")
+	}
+	n, err := strconv.Atoi(fields[2])
+	if err != nil || n < 0 {
+		return 0, syntaxError(line, "invalid number of vertices: %q", fields[2])
+	}
+	m, err := strconv.Atoi(fields[3])
+	if err != nil || m < 0 {
+		return 0, syntaxError(line, "invalid number of arcs or edges: %q", fields[3])
+	}
+
+	switch Problem(fields[1]) {
+	case ShortestPath, MaxFlow:
+		inst.Problem = Problem(fields[1])
+		inst.Graph = graph.NewDirected(opts...)
+	case Coloring, "col":
+		inst.Problem = Coloring
+		inst.Graph = graph.NewUndirected(opts...)
+	default:
+		return 0, syntaxError(line, "unsupported problem: %q", fields[1])
+	}
+
+	vertices := make([]*graph.Vertex, n)
+	for i := range vertices {
+		vertices[i] = graph.NewVertex(i + 1)
+	}
+	if err := inst.Graph.AddVertices(vertices...); err != nil {
+		return 0, err
+	}
+	return m, nil
+}
+
+// node parses the source or the sink line of MaxFlow.
+func (inst *Instance) node(line int, fields []string) error {
+	if inst.Problem != MaxFlow {
+		return syntaxError(line, "node line in %s problem", inst.Problem)
+	}
+	if len(fields) != 3 {
+		return syntaxError(line, "expected \"n <vertex> s|t\"")
+	}
+	v, err := inst.vertex(line, fields[1])
+	if err != nil {
+		return err
+	}
+
+	switch fields[2] {
+	case "s":
+		if inst.Source != nil {
+			return syntaxError(line, "duplicate source line")
+		}
+		inst.Source = v
+	case "t":
+		if inst.Sink != nil {
+			return syntaxError(line, "duplicate sink line")
+		}
+		inst.Sink = v
+	default:
+		return syntaxError(line, "expected s or t, found %q", fields[2])
+	}
+	return nil
+}
+
+// edge parses an arc line "a u v weight" or an edge line "e u v".
+func (inst *Instance) edge(line int, fields []string) error {
+	weight := float64(EdgeWeight)
+	switch {
+	case fields[0] == "e" && inst.Problem != Coloring:
+		return syntaxError(line, "edge line in %s problem", inst.Problem)
+	case fields[0] == "a" && inst.Problem == Coloring:
+		return syntaxError(line, "arc line in %s problem", inst.Problem)
+	case fields[0] == "e" && len(fields) != 3:
+		return syntaxError(line, "expected \"e u v\"")
+	case fields[0] == "a" && len(fields) != 4:
+		return syntaxError(line, "expected \"a u v weight\"")
+	case fields[0] == "a":
+		var err error
+		if weight, err = strconv.ParseFloat(fields[3], 64); err != nil {
+			return syntaxError(line, "invalid weight: %q", fields[3])
+		}
+	}
+
+	start, err := inst.vertex(line, fields[1])
+	if err != nil {
+		return err
+	}
+	end, err := inst.vertex(line, fields[2])
+	if err != nil {
+		return err
+	}
+	if inst.Problem == Coloring && start != end {
+		for _, e := range inst.Graph.FindEdges(end, start) {
+			if e.Start() == end {
+				return nil // Listed in both directions
+			}
+		}
+	}
+	if err := inst.Graph.AddEdges(graph.NewEdge(start, end, weight)); err != nil {
+		return fmt.Errorf("line %d: %w", line, err)
+	}
+	return nil
+}
+
+// vertex retrieves the Vertex numbered by the field.
+func (inst *Instance) vertex(line int, field string) (*graph.Vertex, error) {
+	value, err := strconv.Atoi(field)
+	if err != nil {
+		return nil, syntaxError(line, "invalid vertex: %q", field)
+	}
+	v, ok := inst.Graph.Vertex(value)
+	if !ok {
+		return nil, fmt.Errorf("line %d: vertex %w: %d", line, graph.ErrNotExists, value)
+	}
+	return v, nil
+}
+
+func syntaxError(line int, format string, a ...any) error {
+	return &graph.SyntaxError{Line: line, Msg: fmt.Sprintf(format, a...)}
+}
+
+// Write writes a DIMACS problem instance: comments, the problem line, for
+// MaxFlow the source and sink lines, and a line for every edge. Coloring
+// edges are written without weights.
+//
+// Returns an error if Graph vertex values are not numbered from 1 to n, Graph
+// is not directed for ShortestPath and MaxFlow or is directed for Coloring,
+// or MaxFlow source or sink is missing. Returns an error wrapping
+// graph.ErrNotExists if source or sink is not a Vertex of Graph.
+func Write(w io.Writer, inst *Instance) error {
+	g := inst.Graph
+	n := len(g.GetVertices())
+	for _, v := range g.GetVertices() {
+		if v.Value < 1 || v.Value > n {
+			return fmt.Errorf("dimacs: vertex %d out of range 1 to %d", v.Value, n)
+		}
+	}
+
+	kind := "a"
+	switch inst.Problem {
+	case ShortestPath, MaxFlow:
+		if !g.IsDirected() {
+			return fmt.Errorf("dimacs: %s problem with undirected graph", inst.Problem)
+		}
+	case Coloring:
+		if g.IsDirected() {
+			return fmt.Errorf("dimacs: %s problem with directed graph", inst.Problem)
+		}
+		kind = "e"
+	default:
+		return fmt.Errorf("dimacs: unsupported problem: %q", inst.Problem)
+	}
+	if inst.Problem == MaxFlow {
+		if inst.Source == nil || inst.Sink == nil {
+			return fmt.Errorf("dimacs: %s problem without source or sink", inst.Problem)
+		}
+		for _, v := range []*graph.Vertex{inst.Source, inst.Sink} {
+			if w, ok := g.Vertex(v.Value); !ok || w != v {
+				return fmt.Errorf("dimacs: source or sink %w: %d", graph.ErrNotExists, v.Value)
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, c := range inst.Comments {
+		if c == "" {
+			bw.WriteString("c\n")
+		} else {
+			fmt.Fprintf(bw, "c %s\n", c)
+		}
+	}
+	fmt.Fprintf(bw, "p %s %d %d\n", inst.Problem, n, len(g.GetEdges()))
+	if inst.Problem == MaxFlow {
+		fmt.Fprintf(bw, "n %d s\nn %d t\n", inst.Source.Value, inst.Sink.Value)
+	}
+	for _, e := range g.GetEdges() {
+		fmt.Fprintf(bw, "%s %d %d", kind, e.Start().Value, e.End().Value)
+		if kind == "a" {
+			bw.WriteString(" " + strconv.FormatFloat(e.Weight, 'g', -1, 64))
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,222 @@
+package dimacs
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sewiti/ktu-testing/pkg/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	t.Run("should read shortest path problem", func(t *testing.T) {
+		inst := readFile(t, "testdata/sample.sp")
+
+		assert.Equal(t, ShortestPath, inst.Problem)
+		assert.Equal(t, []string{
+			"9th DIMACS Implementation Challenge: Shortest Paths",
+			"Sample graph",
+			"",
+		}, inst.Comments)
+
+		g := inst.Graph
+		assert.True(t, g.IsDirected())
+		assert.Equal(t, "1 2 3 4 5 6", g.String())
+		assert.Len(t, g.GetEdges(), 8)
+
+		v1, _ := g.Vertex(1)
+		v6, _ := g.Vertex(6)
+		p, err := graph.ShortestPaths(g, v1)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(15), p.DistanceTo(v6))
+	})
+
+	t.Run("should read max flow problem", func(t *testing.T) {
+		inst := readFile(t, "testdata/sample.max")
+
+		assert.Equal(t, MaxFlow, inst.Problem)
+		assert.Equal(t, 1, inst.Source.Value)
+		assert.Equal(t, 6, inst.Sink.Value)
+		assert.True(t, inst.Graph.IsDirected())
+		assert.Equal(t, float64(60), inst.Graph.GetWeight())
+
+		v1, _ := inst.Graph.Vertex(1)
+		assert.Same(t, v1, inst.Source)
+	})
+
+	t.Run("should read coloring problem", func(t *testing.T) {
+		inst := readFile(t, "testdata/sample.col")
+
+		assert.Equal(t, Coloring, inst.Problem)
+		assert.False(t, inst.Graph.IsDirected())
+		assert.Len(t, inst.Graph.GetEdges(), 6)
+		for _, e := range inst.Graph.GetEdges() {
+			assert.Equal(t, float64(EdgeWeight), e.Weight)
+		}
+
+		v1, _ := inst.Graph.Vertex(1)
+		assert.Equal(t, 3, v1.GetDegree())
+	})
+
+	t.Run("should read col problem as coloring", func(t *testing.T) {
+		inst, err := Read(strings.NewReader("p col 3 1\ne 1 2\n"))
+		assert.NoError(t, err)
+		assert.Equal(t, Coloring, inst.Problem)
+		assert.Equal(t, "1 2 3", inst.Graph.String())
+	})
+
+	t.Run("should count edges listed in both directions once", func(t *testing.T) {
+		inst, err := Read(strings.NewReader("p edge 3 4\ne 1 2\ne 2 1\ne 2 3\ne 3 2\n"))
+		assert.NoError(t, err)
+
+		var edges []string
+		for _, e := range inst.Graph.GetEdges() {
+			edges = append(edges, e.String())
+		}
+		assert.Equal(t, []string{"1 to 2", "2 to 3"}, edges)
+	})
+
+	t.Run("should create graph with options", func(t *testing.T) {
+		src := "p edge 2 2\ne 1 2\ne 1 2\n"
+
+		_, err := Read(strings.NewReader(src))
+		assert.ErrorIs(t, err, graph.ErrParallelEdge)
+
+		inst, err := Read(strings.NewReader(src), graph.AllowMultiEdges())
+		assert.NoError(t, err)
+		assert.Len(t, inst.Graph.GetEdges(), 2)
+	})
+
+	syntaxTests := []struct {
+		name string
+		src  string
+		msg  string
+	}{
+		{name: "missing problem line", src: "c empty\n", msg: "line 1: missing problem line"},
+		{name: "arc before problem line", src: "a 1 2 3\np sp 2 1", msg: `line 1: expected problem line before "a"`},
+		{name: "duplicate problem line", src: "p sp 2 0\np sp 2 0", msg: "line 2: duplicate problem line"},
+		{name: "unsupported problem", src: "p cut 2 0", msg: `line 1: unsupported problem: "cut"`},
+		{name: "invalid vertices count", src: "p sp -1 0", msg: `line 1: invalid number of vertices: "-1"`},
+		{name: "invalid arcs count", src: "p sp 1 x", msg: `line 1: invalid number of arcs or edges: "x"`},
+		{name: "malformed problem line", src: "p sp 1", msg: `line 1: expected "p <problem> n m"`},
+		{name: "unknown line type", src: "p sp 1 0\nx 1", msg: `line 2: unknown line type "x"`},
+		{name: "arcs count mismatch", src: "p sp 2 2\na 1 2 1\n", msg: "line 2: expected 2 arcs or edges, found 1"},
+		{name: "invalid weight", src: "p sp 2 1\na 1 2 far", msg: `line 2: invalid weight: "far"`},
+		{name: "invalid vertex", src: "p sp 2 1\na 1 b 1", msg: `line 2: invalid vertex: "b"`},
+		{name: "malformed arc", src: "p sp 2 1\na 1 2", msg: `line 2: expected "a u v weight"`},
+		{name: "malformed edge", src: "p edge 2 1\ne 1 2 3", msg: `line 2: expected "e u v"`},
+		{name: "edge in sp problem", src: "p sp 2 1\ne 1 2", msg: "line 2: edge line in sp problem"},
+		{name: "arc in coloring problem", src: "p edge 2 1\na 1 2 1", msg: "line 2: arc line in edge problem"},
+		{name: "node in sp problem", src: "p sp 2 0\nn 1 s", msg: "line 2: node line in sp problem"},
+		{name: "invalid node kind", src: "p max 2 0\nn 1 x", msg: `line 2: expected s or t, found "x"`},
+		{name: "duplicate source", src: "p max 2 0\nn 1 s\nn 2 s", msg: "line 3: duplicate source line"},
+		{name: "missing sink", src: "p max 2 0\nn 1 s\n", msg: "line 2: missing sink line"},
+	}
+
+	for _, tt := range syntaxTests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			inst, err := Read(strings.NewReader(tt.src))
+			assert.Nil(t, inst)
+
+			var syntaxErr *graph.SyntaxError
+			assert.True(t, errors.As(err, &syntaxErr))
+			assert.EqualError(t, err, tt.msg)
+		})
+	}
+
+	t.Run("should reject vertex out of range", func(t *testing.T) {
+		_, err := Read(strings.NewReader("p sp 2 1\n\na 1 3 1\n"))
+		assert.ErrorIs(t, err, graph.ErrNotExists)
+		assert.EqualError(t, err, "line 3: vertex does not exist: 3")
+	})
+}
+
+func TestWrite(t *testing.T) {
+	for _, name := range []string{"sample.sp", "sample.max", "sample.col"} {
+		t.Run("should round-trip "+name, func(t *testing.T) {
+			want, err := os.ReadFile("testdata/" + name)
+			assert.NoError(t, err)
+
+			inst := readFile(t, "testdata/"+name)
+
+			var buf bytes.Buffer
+			assert.NoError(t, Write(&buf, inst))
+			assert.Equal(t, string(want), buf.String())
+
+			decoded, err := Read(&buf)
+			assert.NoError(t, err)
+			assert.True(t, inst.Graph.Equal(decoded.Graph))
+		})
+	}
+
+	t.Run("should write graph built in code", func(t *testing.T) {
+		g := graph.NewDirected()
+		_, err := g.AddEdgeByValue(2, 1, 2.5)
+		assert.NoError(t, err)
+
+		var buf bytes.Buffer
+		assert.NoError(t, Write(&buf, &Instance{Problem: ShortestPath, Graph: g}))
+		assert.Equal(t, "p sp 2 1\na 2 1 2.5\n", buf.String())
+	})
+
+	t.Run("should reject vertices out of range", func(t *testing.T) {
+		g := graph.NewDirected()
+		_, err := g.AddEdgeByValue(0, 1, 1)
+		assert.NoError(t, err)
+
+		err = Write(&bytes.Buffer{}, &Instance{Problem: ShortestPath, Graph: g})
+		assert.EqualError(t, err, "dimacs: vertex 0 out of range 1 to 2")
+	})
+
+	t.Run("should reject max flow without sink", func(t *testing.T) {
+		g := graph.NewDirected()
+		assert.NoError(t, g.AddVertices(graph.NewVertex(1)))
+		v1, _ := g.Vertex(1)
+
+		err := Write(&bytes.Buffer{}, &Instance{Problem: MaxFlow, Graph: g, Source: v1})
+		assert.EqualError(t, err, "dimacs: max problem without source or sink")
+	})
+
+	t.Run("should reject graph of wrong direction", func(t *testing.T) {
+		err := Write(&bytes.Buffer{}, &Instance{Problem: ShortestPath, Graph: graph.NewUndirected()})
+		assert.EqualError(t, err, "dimacs: sp problem with undirected graph")
+
+		err = Write(&bytes.Buffer{}, &Instance{Problem: Coloring, Graph: graph.NewDirected()})
+		assert.EqualError(t, err, "dimacs: edge problem with directed graph")
+	})
+
+	t.Run("should reject max flow source or sink not in graph", func(t *testing.T) {
+		g := graph.NewDirected()
+		_, err := g.AddEdgeByValue(1, 2, 1)
+		assert.NoError(t, err)
+		v1, _ := g.Vertex(1)
+
+		err = Write(&bytes.Buffer{}, &Instance{Problem: MaxFlow, Graph: g, Source: v1, Sink: graph.NewVertex(2)})
+		assert.ErrorIs(t, err, graph.ErrNotExists)
+		assert.EqualError(t, err, "dimacs: source or sink does not exist: 2")
+	})
+
+	t.Run("should reject unsupported problem", func(t *testing.T) {
+		err := Write(&bytes.Buffer{}, &Instance{Problem: "cut", Graph: graph.NewDirected()})
+		assert.EqualError(t, err, `dimacs: unsupported problem: "cut"`)
+	})
+}
+
+func readFile(t *testing.T, name string) *Instance {
+	t.Helper()
+
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	inst, err := Read(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return inst
+}
@@ -0,0 +1,211 @@
+// Package edgelist reads and writes graphs as plain text edge lists and
+// adjacency lists, as used by public datasets like SNAP.
+//
+// In both formats vertices are identified by their integer values, fields are
+// separated by whitespace, everything after '#' is a comment and blank lines
+// are ignored.
+package edgelist
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sewiti/ktu-testing/pkg/graph"
+)
+
+// DefaultWeight is the weight of edges read without one.
+const DefaultWeight = 1
+
+// Read reads edges into Graph, one per line: "u v [weight]". A line with a
+// single value "u" adds an isolated vertex. Vertices are created by values
+// as needed, edges without a weight weigh DefaultWeight.
+//
+// Undirected datasets often list every edge in both directions, so in an
+// undirected Graph a line "v u" is skipped if Graph already has an edge "u v"
+// of the same weight.
+//
+// Lines are added atomically: on failure Graph is left intact.
+//
+// Returns *graph.SyntaxError if a line is malformed, or an error prefixed with
+// the line number if Graph rejects an edge, see graph.Graph.AddEdges.
+func Read(r io.Reader, g *graph.Graph) error {
+	return read(r, g, func(tx *graph.Tx, line int, fields []string) error {
+		if len(fields) > 3 {
+			return syntaxError(line, "expected \"u v [weight]\", found %d fields", len(fields))
+		}
+		values := fields
+		if len(values) == 3 {
+			values = values[:2]
+		}
+		u, err := parseValues(line, values)
+		if err != nil {
+			return err
+		}
+		if len(u) == 1 {
+			return addVertex(tx, line, u[0])
+		}
+
+		weight := float64(DefaultWeight)
+		if len(fields) == 3 {
+			if weight, err = strconv.ParseFloat(fields[2], 64); err != nil {
+				return syntaxError(line, "invalid weight: %q", fields[2])
+			}
+		}
+		return addEdge(tx, line, u[0], u[1], weight)
+	})
+}
+
+// ReadAdjacency reads edges into Graph from adjacency lines:
+// "u: v1 v2 ...", adding an edge from u to every listed vertex. Vertices are
+// created by values as needed, including u without listed vertices, edges
+// weigh DefaultWeight.
+//
+// Like in Read, an edge from u to v is skipped in an undirected Graph if
+// Graph already has an edge from v to u, so that adjacency lists listing
+// every edge at both ends can be read.
+//
+// Lines are added atomically: on failure Graph is left intact.
+//
+// Returns *graph.SyntaxError if a line is malformed, or an error prefixed with
+// the line number if Graph rejects an edge, see graph.Graph.AddEdges.
+func ReadAdjacency(r io.Reader, g *graph.Graph) error {
+	return read(r, g, func(tx *graph.Tx, line int, fields []string) error {
+		s := strings.Join(fields, " ")
+		i := strings.IndexByte(s, ':')
+		if i < 0 {
+			return syntaxError(line, "expected \"u: v1 v2 ...\", missing colon")
+		}
+		head := strings.Fields(s[:i])
+		if len(head) != 1 {
+			return syntaxError(line, "expected a single vertex before colon, found %d", len(head))
+		}
+
+		u, err := parseValues(line, append(head, strings.Fields(s[i+1:])...))
+		if err != nil {
+			return err
+		}
+		if err := addVertex(tx, line, u[0]); err != nil {
+			return err
+		}
+		for _, v := range u[1:] {
+			if err := addEdge(tx, line, u[0], v, DefaultWeight); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// read adds lines to Graph in a batch, calling add with the fields of every
+// line which is not blank.
+func read(r io.Reader, g *graph.Graph, add func(tx *graph.Tx, line int, fields []string) error) error {
+	var failed error
+	err := g.Batch(func(tx *graph.Tx) error {
+		br := bufio.NewReader(r)
+		for line := 1; ; line++ {
+			s, err := br.ReadString('\n')
+			if err != nil && err != io.EOF {
+				failed = err
+				return err
+			}
+
+			if i := strings.IndexByte(s, '#'); i >= 0 {
+				s = s[:i]
+			}
+			if fields := strings.Fields(s); len(fields) > 0 {
+				if err := add(tx, line, fields); err != nil {
+					failed = err
+					return err
+				}
+			}
+			if err == io.EOF {
+				return nil
+			}
+		}
+	})
+	if failed != nil {
+		return failed
+	}
+	return err
+}
+
+// addEdge adds an edge from u to v, unless Graph is undirected and already has
+// an edge from v to u of the same weight.
+func addEdge(tx *graph.Tx, line, u, v int, weight float64) error {
+	if g := tx.Graph(); !g.IsDirected() && u != v {
+		start, _ := g.Vertex(v)
+		end, _ := g.Vertex(u)
+		for _, e := range g.FindEdges(start, end) {
+			if e.Start() == start && e.Weight == weight {
+				return nil
+			}
+		}
+	}
+	if _, err := tx.AddEdgeByValue(u, v, weight); err != nil {
+		return fmt.Errorf("line %d: %w", line, err)
+	}
+	return nil
+}
+
+// addVertex adds a vertex of the value, unless Graph already has it.
+func addVertex(tx *graph.Tx, line, value int) error {
+	if _, ok := tx.Graph().Vertex(value); ok {
+		return nil
+	}
+	if err := tx.AddVertices(graph.NewVertex(value)); err != nil {
+		return fmt.Errorf("line %d: %w", line, err)
+	}
+	return nil
+}
+
+// parseValues parses vertex values.
+func parseValues(line int, fields []string) ([]int, error) {
+	values := make([]int, len(fields))
+	for i, f := range fields {
+		value, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, syntaxError(line, "invalid vertex value: %q", f)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func syntaxError(line int, format string, a ...any) error {
+	return &graph.SyntaxError{Line: line, Msg: fmt.Sprintf(format, a...)}
+}
+
+// Write writes Graph edges, one per line: "u v weight". Vertices without
+// edges are written as lines with a single value, so that Read restores them.
+func Write(w io.Writer, g *graph.Graph) error {
+	bw := bufio.NewWriter(w)
+	for _, v := range g.GetVertices() {
+		if len(v.GetEdges()) == 0 && v.InDegree() == 0 {
+			fmt.Fprintf(bw, "%d\n", v.Value)
+		}
+	}
+	for _, e := range g.GetEdges() {
+		fmt.Fprintf(bw, "%d %d %s\n", e.Start().Value, e.End().Value, strconv.FormatFloat(e.Weight, 'g', -1, 64))
+	}
+	return bw.Flush()
+}
+
+// WriteAdjacency writes Graph as adjacency lines: "u: v1 v2 ...", one per
+// Vertex, listing the ends of the edges starting at it. Every edge is written
+// once, weights are not written.
+func WriteAdjacency(w io.Writer, g *graph.Graph) error {
+	bw := bufio.NewWriter(w)
+	for _, v := range g.GetVertices() {
+		fmt.Fprintf(bw, "%d:", v.Value)
+		for _, e := range v.GetEdges() {
+			if e.Start() == v {
+				fmt.Fprintf(bw, " %d", e.End().Value)
+			}
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
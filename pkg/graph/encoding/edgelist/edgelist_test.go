@@ -0,0 +1,191 @@
+package edgelist
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sewiti/ktu-testing/pkg/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	t.Run("should read SNAP edge list", func(t *testing.T) {
+		src := `# Directed graph (each unordered pair of nodes is saved once): sample.txt
+# Nodes: 4 Edges: 3
+# FromNodeId	ToNodeId
+0	1
+0	2 2.5
+  # Indented comment
+
+2 3 -1 # Trailing comment
+7
+`
+		g := graph.NewDirected()
+		assert.NoError(t, Read(strings.NewReader(src), g))
+
+		assert.Equal(t, "0 1 2 3 7", g.String())
+		edges := g.GetEdges()
+		assert.Len(t, edges, 3)
+		assert.Equal(t, "0 to 1", edges[0].String())
+		assert.Equal(t, float64(DefaultWeight), edges[0].Weight)
+		assert.Equal(t, 2.5, edges[1].Weight)
+		assert.Equal(t, float64(-1), edges[2].Weight)
+	})
+
+	t.Run("should add to existing vertices", func(t *testing.T) {
+		v0 := graph.NewVertex(0)
+		g := graph.NewUndirected()
+		assert.NoError(t, g.AddVertices(v0))
+
+		assert.NoError(t, Read(strings.NewReader("1 0\n0"), g))
+		assert.Equal(t, "0 1", g.String())
+		assert.Len(t, v0.GetEdges(), 1)
+	})
+
+	t.Run("should skip reverse edges in undirected graph", func(t *testing.T) {
+		g := graph.NewUndirected()
+		assert.NoError(t, Read(strings.NewReader("0 1\n1 0\n1 2 2\n2 1 2\n"), g))
+
+		var edges []string
+		for _, e := range g.GetEdges() {
+			edges = append(edges, e.String())
+		}
+		assert.Equal(t, []string{"0 to 1", "1 to 2"}, edges)
+
+		d := graph.NewDirected()
+		assert.NoError(t, Read(strings.NewReader("0 1\n1 0\n"), d))
+		assert.Len(t, d.GetEdges(), 2)
+	})
+
+	t.Run("should round-trip graph", func(t *testing.T) {
+		g := graph.NewDirected(graph.AllowSelfLoops())
+		_, err := g.AddEdgeByValue(3, 1, 0.125)
+		assert.NoError(t, err)
+		_, err = g.AddEdgeByValue(1, 1, 2)
+		assert.NoError(t, err)
+		assert.NoError(t, g.AddVertices(graph.NewVertex(9)))
+
+		var buf bytes.Buffer
+		assert.NoError(t, Write(&buf, g))
+		assert.Equal(t, "9\n3 1 0.125\n1 1 2\n", buf.String())
+
+		decoded := graph.NewDirected(graph.AllowSelfLoops())
+		assert.NoError(t, Read(&buf, decoded))
+		assert.True(t, g.Equal(decoded))
+	})
+
+	tests := []struct {
+		name string
+		src  string
+		line int
+		msg  string
+	}{
+		{name: "too many fields", src: "0 1\n0 1 2 3", line: 2, msg: `line 2: expected "u v [weight]", found 4 fields`},
+		{name: "invalid value", src: "\n\nx 1", line: 3, msg: `line 3: invalid vertex value: "x"`},
+		{name: "invalid weight", src: "0 1 heavy", line: 1, msg: `line 1: invalid weight: "heavy"`},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			g := graph.NewDirected()
+			err := Read(strings.NewReader(tt.src), g)
+
+			var syntaxErr *graph.SyntaxError
+			if assert.True(t, errors.As(err, &syntaxErr)) {
+				assert.Equal(t, tt.line, syntaxErr.Line)
+			}
+			assert.EqualError(t, err, tt.msg)
+			assert.Empty(t, g.GetVertices())
+			assert.Empty(t, g.GetEdges())
+		})
+	}
+
+	t.Run("should report line of rejected edge", func(t *testing.T) {
+		g := graph.NewUndirected()
+		_, err := g.AddEdgeByValue(5, 6, 1)
+		assert.NoError(t, err)
+
+		err = Read(strings.NewReader("0 1\n1 2\n# Reverse of another weight\n2 1 3\n"), g)
+		assert.ErrorIs(t, err, graph.ErrParallelEdge)
+		assert.EqualError(t, err, "line 4: edge is parallel to an existing edge: 2 to 1")
+
+		// Graph is left intact
+		assert.Equal(t, "5 6", g.String())
+		assert.Len(t, g.GetEdges(), 1)
+	})
+}
+
+func TestReadAdjacency(t *testing.T) {
+	t.Run("should read adjacency lines", func(t *testing.T) {
+		src := `# Adjacency list
+0: 1 2
+1:2
+2:
+3 : 0 # Trailing comment
+`
+		g := graph.NewDirected()
+		assert.NoError(t, ReadAdjacency(strings.NewReader(src), g))
+
+		assert.Equal(t, "0 1 2 3", g.String())
+		var edges []string
+		for _, e := range g.GetEdges() {
+			edges = append(edges, e.String())
+			assert.Equal(t, float64(DefaultWeight), e.Weight)
+		}
+		assert.Equal(t, []string{"0 to 1", "0 to 2", "1 to 2", "3 to 0"}, edges)
+	})
+
+	t.Run("should read edges listed at both ends", func(t *testing.T) {
+		g := graph.NewUndirected()
+		assert.NoError(t, ReadAdjacency(strings.NewReader("0: 1 2\n1: 0 2\n2: 0 1\n"), g))
+		assert.Len(t, g.GetEdges(), 3)
+	})
+
+	t.Run("should round-trip undirected graph", func(t *testing.T) {
+		g := graph.NewUndirected()
+		for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 2}} {
+			_, err := g.AddEdgeByValue(e[0], e[1], DefaultWeight)
+			assert.NoError(t, err)
+		}
+		assert.NoError(t, g.AddVertices(graph.NewVertex(4)))
+
+		var buf bytes.Buffer
+		assert.NoError(t, WriteAdjacency(&buf, g))
+		assert.Equal(t, "0: 1\n1: 2\n2: 0\n3: 2\n4:\n", buf.String())
+
+		decoded := graph.NewUndirected()
+		assert.NoError(t, ReadAdjacency(&buf, decoded))
+		assert.True(t, g.Equal(decoded))
+		assert.Equal(t, g.String(), decoded.String())
+	})
+
+	tests := []struct {
+		name string
+		src  string
+		msg  string
+	}{
+		{name: "missing colon", src: "0: 1\n1 2", msg: `line 2: expected "u: v1 v2 ...", missing colon`},
+		{name: "missing vertex", src: ": 1", msg: `line 1: expected a single vertex before colon, found 0`},
+		{name: "invalid value", src: "0: 1 b", msg: `line 1: invalid vertex value: "b"`},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			g := graph.NewDirected()
+			err := ReadAdjacency(strings.NewReader(tt.src), g)
+
+			var syntaxErr *graph.SyntaxError
+			assert.True(t, errors.As(err, &syntaxErr))
+			assert.EqualError(t, err, tt.msg)
+			assert.Empty(t, g.GetVertices())
+		})
+	}
+
+	t.Run("should report line of rejected edge", func(t *testing.T) {
+		err := ReadAdjacency(strings.NewReader("0: 1\n1: 1"), graph.NewDirected())
+		assert.ErrorIs(t, err, graph.ErrSelfLoop)
+		assert.EqualError(t, err, "line 2: edge is a self-loop: 1 to 1")
+	})
+}
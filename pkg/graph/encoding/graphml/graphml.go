@@ -0,0 +1,537 @@
+// Package graphml reads and writes graphs in the GraphML format, used by
+// tools like yEd and Gephi.
+//
+// Edge weights are stored in the edge key named WeightKey, vertices and edges
+// attributes are stored in keys named after them. Keys are typed by the
+// attribute values: bool, int, int64, float32 and float64 values are stored as
+// boolean, int, long, float and double, everything else is formatted with
+// fmt.Sprint and stored as a string.
+package graphml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sewiti/ktu-testing/pkg/graph"
+)
+
+const (
+	// WeightKey is the name of the edge key holding edge weights.
+	WeightKey = "weight"
+
+	// IDAttr is the vertex attribute holding the original node ID which is
+	// not an integer, see Read. It is prefixed not to clash with the
+	// attributes of the document keys.
+	IDAttr = "graphml:id"
+
+	namespace = "http://graphml.graphdrawing.org/xmlns"
+)
+
+// ErrUnsupported reports that the document uses GraphML features which can't
+// be represented by Graph, like nested graphs or mixed edge directions.
+var ErrUnsupported = errors.New("unsupported")
+
+type xmlKey struct {
+	XMLName xml.Name `xml:"key"`
+	ID      string   `xml:"id,attr"`
+	For     string   `xml:"for,attr"`
+	Name    string   `xml:"attr.name,attr,omitempty"`
+	Type    string   `xml:"attr.type,attr,omitempty"`
+	Default *string  `xml:"default"`
+}
+
+type xmlData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xmlNode struct {
+	XMLName xml.Name   `xml:"node"`
+	ID      string     `xml:"id,attr"`
+	Data    []xmlData  `xml:"data"`
+	Graphs  []struct{} `xml:"graph"`
+}
+
+type xmlEdge struct {
+	XMLName  xml.Name  `xml:"edge"`
+	Source   string    `xml:"source,attr"`
+	Target   string    `xml:"target,attr"`
+	Directed string    `xml:"directed,attr,omitempty"`
+	Data     []xmlData `xml:"data"`
+}
+
+// Write writes Graph as a GraphML document: key declarations for edge
+// weights and every vertex and edge attribute, followed by nodes identified
+// by vertex values and edges. Edge attributes named WeightKey are not
+// written, as the key holds weights.
+//
+// The document is encoded as it is written, without building it in memory.
+func Write(w io.Writer, g *graph.Graph) error {
+	weight := &xmlKey{ID: "d0", For: "edge", Name: WeightKey, Type: "double"}
+	keys := []*xmlKey{weight}
+	nodeKeys := declareKeys(&keys, "node", vertexAttrs(g))
+	edgeKeys := declareKeys(&keys, "edge", edgeAttrs(g))
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	root := xml.StartElement{
+		Name: xml.Name{Local: "graphml"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: namespace}},
+	}
+	if err := enc.EncodeToken(root); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := enc.Encode(k); err != nil {
+			return err
+		}
+	}
+
+	edgedefault := "undirected"
+	if g.IsDirected() {
+		edgedefault = "directed"
+	}
+	start := xml.StartElement{
+		Name: xml.Name{Local: "graph"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "id"}, Value: "G"},
+			{Name: xml.Name{Local: "edgedefault"}, Value: edgedefault},
+		},
+	}
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, v := range g.GetVertices() {
+		node := xmlNode{ID: strconv.Itoa(v.Value), Data: encodeData(nodeKeys, v.Attrs())}
+		if err := enc.Encode(node); err != nil {
+			return err
+		}
+	}
+	for _, e := range g.GetEdges() {
+		attrs := e.Attrs()
+		delete(attrs, WeightKey)
+		edge := xmlEdge{
+			Source: strconv.Itoa(e.Start().Value),
+			Target: strconv.Itoa(e.End().Value),
+			Data: append(
+				[]xmlData{{Key: weight.ID, Value: formatValue(e.Weight)}},
+				encodeData(edgeKeys, attrs)...,
+			),
+		}
+		if err := enc.Encode(edge); err != nil {
+			return err
+		}
+	}
+
+	if err := enc.EncodeToken(start.End()); err != nil {
+		return err
+	}
+	if err := enc.EncodeToken(root.End()); err != nil {
+		return err
+	}
+	if err := enc.Flush(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// vertexAttrs retrieves types of all vertices attributes by their names.
+func vertexAttrs(g *graph.Graph) map[string]string {
+	types := make(map[string]string)
+	for _, v := range g.GetVertices() {
+		mergeTypes(types, v.Attrs())
+	}
+	return types
+}
+
+// edgeAttrs retrieves types of all edges attributes by their names.
+func edgeAttrs(g *graph.Graph) map[string]string {
+	types := make(map[string]string)
+	for _, e := range g.GetEdges() {
+		attrs := e.Attrs()
+		delete(attrs, WeightKey)
+		mergeTypes(types, attrs)
+	}
+	return types
+}
+
+// mergeTypes adds types of the attributes, falling back to string if the
+// same attribute has values of different types.
+func mergeTypes(types map[string]string, attrs map[string]any) {
+	for name, value := range attrs {
+		typ := valueType(value)
+		if prev, ok := types[name]; ok && prev != typ {
+			typ = "string"
+		}
+		types[name] = typ
+	}
+}
+
+// declareKeys appends keys for the attributes sorted by names, retrieving
+// keys by attribute names.
+func declareKeys(keys *[]*xmlKey, domain string, types map[string]string) map[string]*xmlKey {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	byName := make(map[string]*xmlKey, len(names))
+	for _, name := range names {
+		k := &xmlKey{ID: "d" + strconv.Itoa(len(*keys)), For: domain, Name: name, Type: types[name]}
+		*keys = append(*keys, k)
+		byName[name] = k
+	}
+	return byName
+}
+
+// encodeData retrieves data elements of the attributes sorted by key IDs.
+func encodeData(keys map[string]*xmlKey, attrs map[string]any) []xmlData {
+	data := make([]xmlData, 0, len(attrs))
+	for name, value := range attrs {
+		data = append(data, xmlData{Key: keys[name].ID, Value: formatValue(value)})
+	}
+	sort.Slice(data, func(i, j int) bool {
+		a, _ := strconv.Atoi(data[i].Key[1:])
+		b, _ := strconv.Atoi(data[j].Key[1:])
+		return a < b
+	})
+	return data
+}
+
+// valueType retrieves the GraphML type of the value.
+func valueType(value any) string {
+	switch value.(type) {
+	case bool:
+		return "boolean"
+	case int, int8, int16, int32, uint8, uint16:
+		return "int"
+	case int64, uint32:
+		return "long"
+	case float32:
+		return "float"
+	case float64:
+		return "double"
+	}
+	return "string"
+}
+
+func formatValue(value any) string {
+	switch value := value.(type) {
+	case float32:
+		return strconv.FormatFloat(float64(value), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+// key is a declared GraphML key.
+type key struct {
+	domain string
+	name   string
+	typ    string
+	def    *string
+}
+
+// appliesTo reports whether the key is declared for the domain.
+func (k *key) appliesTo(domain string) bool {
+	return k.domain == domain || k.domain == "all"
+}
+
+// parse parses the data value according to the key type.
+func (k *key) parse(s string) (any, error) {
+	switch k.typ {
+	case "boolean":
+		return strconv.ParseBool(strings.TrimSpace(s))
+	case "int":
+		return strconv.Atoi(strings.TrimSpace(s))
+	case "long":
+		return strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	case "float":
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 32)
+		return float32(f), err
+	case "double":
+		return strconv.ParseFloat(strings.TrimSpace(s), 64)
+	}
+	return s, nil
+}
+
+// reader holds the state of a document being decoded.
+type reader struct {
+	dec      *xml.Decoder
+	opts     []graph.Option
+	keys     map[string]*key
+	g        *graph.Graph
+	nodes    []string // Node IDs in document order.
+	vertices map[string]*graph.Vertex
+	edges    []pendingEdge
+}
+
+// pendingEdge is an edge read before the values of its nodes are assigned.
+type pendingEdge struct {
+	source, target string
+	weight         float64
+	attrs          graph.Attributes
+}
+
+// Read reads a Graph from a GraphML document. The document is decoded as a
+// stream, element by element, so it doesn't have to fit in memory, only the
+// Graph does. Graph is created with the options given and is directed
+// according to the edgedefault of the document.
+//
+// Nodes with canonical integer IDs become vertices with these values. Other
+// nodes are given the smallest values unused by such IDs in document order,
+// keeping their IDs in the IDAttr attribute. Edges may reference nodes
+// declared after them. Data of the edge key named WeightKey becomes edge
+// weights, other data becomes attributes of the key types, keys without
+// names are ignored. Defaults of the keys are applied. Ports, hyperedges and
+// graph data are ignored.
+//
+// Returns graph.ErrNotExists if an edge references an undeclared node,
+// graph.ErrExists if node IDs are not unique or a node which is not an integer
+// has data of the key named IDAttr, ErrUnsupported if the document
+// contains multiple or nested graphs, or edges of a direction different from
+// edgedefault.
+func Read(r io.Reader, opts ...graph.Option) (*graph.Graph, error) {
+	rd := &reader{
+		dec:      xml.NewDecoder(r),
+		opts:     opts,
+		keys:     make(map[string]*key),
+		vertices: make(map[string]*graph.Vertex),
+	}
+	for {
+		tok, err := rd.dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("graphml: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if err := rd.element(start); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if rd.g == nil {
+		return nil, errors.New("graphml: no graph element")
+	}
+	if err := rd.build(); err != nil {
+		return nil, err
+	}
+	return rd.g, nil
+}
+
+// element decodes the element, descending into graphml and graph elements,
+// skipping the unknown ones.
+func (rd *reader) element(start xml.StartElement) error {
+	switch start.Name.Local {
+	case "graphml":
+		return nil
+	case "key":
+		var k xmlKey
+		if err := rd.dec.DecodeElement(&k, &start); err != nil {
+			return fmt.Errorf("graphml: %w", err)
+		}
+		rd.keys[k.ID] = &key{domain: k.For, name: k.Name, typ: k.Type, def: k.Default}
+		return nil
+	case "graph":
+		return rd.graph(start)
+	case "node":
+		if rd.g == nil {
+			return rd.dec.Skip()
+		}
+		var n xmlNode
+		if err := rd.dec.DecodeElement(&n, &start); err != nil {
+			return fmt.Errorf("graphml: %w", err)
+		}
+		return rd.node(&n)
+	case "edge":
+		if rd.g == nil {
+			return rd.dec.Skip()
+		}
+		var e xmlEdge
+		if err := rd.dec.DecodeElement(&e, &start); err != nil {
+			return fmt.Errorf("graphml: %w", err)
+		}
+		return rd.edge(&e)
+	}
+	return rd.dec.Skip()
+}
+
+func (rd *reader) graph(start xml.StartElement) error {
+	if rd.g != nil {
+		return fmt.Errorf("graphml: multiple graphs %w", ErrUnsupported)
+	}
+
+	edgedefault := "directed"
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "edgedefault" {
+			edgedefault = attr.Value
+		}
+	}
+	switch edgedefault {
+	case "directed":
+		rd.g = graph.NewDirected(rd.opts...)
+	case "undirected":
+		rd.g = graph.NewUndirected(rd.opts...)
+	default:
+		return fmt.Errorf("graphml: invalid edgedefault: %q", edgedefault)
+	}
+	return nil
+}
+
+func (rd *reader) node(n *xmlNode) error {
+	if len(n.Graphs) > 0 {
+		return fmt.Errorf("graphml: node %q: nested graphs %w", n.ID, ErrUnsupported)
+	}
+	if _, ok := rd.vertices[n.ID]; ok {
+		return fmt.Errorf("graphml: node %w: %q", graph.ErrExists, n.ID)
+	}
+
+	v := graph.NewVertex(0)
+	if err := rd.data(&v.Attributes, "node", n.Data, nil); err != nil {
+		return fmt.Errorf("graphml: node %q: %w", n.ID, err)
+	}
+	rd.nodes = append(rd.nodes, n.ID)
+	rd.vertices[n.ID] = v
+	return nil
+}
+
+func (rd *reader) edge(e *xmlEdge) error {
+	if e.Directed != "" {
+		directed, err := strconv.ParseBool(e.Directed)
+		if err != nil {
+			return fmt.Errorf("graphml: edge %q to %q: %w", e.Source, e.Target, err)
+		}
+		if directed != rd.g.IsDirected() {
+			return fmt.Errorf("graphml: edge %q to %q: mixed directions %w", e.Source, e.Target, ErrUnsupported)
+		}
+	}
+
+	p := pendingEdge{source: e.Source, target: e.Target}
+	if err := rd.data(&p.attrs, "edge", e.Data, &p.weight); err != nil {
+		return fmt.Errorf("graphml: edge %q to %q: %w", e.Source, e.Target, err)
+	}
+	rd.edges = append(rd.edges, p)
+	return nil
+}
+
+// build assigns values to the nodes read and adds them to Graph along with the
+// edges between them.
+func (rd *reader) build() error {
+	used := make(map[int]bool)
+	for _, id := range rd.nodes {
+		if value, ok := intID(id); ok {
+			used[value] = true
+		}
+	}
+
+	next := 0
+	vertices := make([]*graph.Vertex, len(rd.nodes))
+	for i, id := range rd.nodes {
+		v := rd.vertices[id]
+		if value, ok := intID(id); ok {
+			v.Value = value
+		} else {
+			for used[next] {
+				next++
+			}
+			v.Value = next
+			next++
+			if _, ok := v.Attr(IDAttr); ok {
+				return fmt.Errorf("graphml: node %q: attribute %w: %q", id, graph.ErrExists, IDAttr)
+			}
+			v.SetAttr(IDAttr, id)
+		}
+		vertices[i] = v
+	}
+	if err := rd.g.AddVertices(vertices...); err != nil {
+		return fmt.Errorf("graphml: %w", err)
+	}
+
+	edges := make([]*graph.Edge, len(rd.edges))
+	for i, p := range rd.edges {
+		start, ok := rd.vertices[p.source]
+		if !ok {
+			return fmt.Errorf("graphml: edge %q to %q: node %w: %q", p.source, p.target, graph.ErrNotExists, p.source)
+		}
+		end, ok := rd.vertices[p.target]
+		if !ok {
+			return fmt.Errorf("graphml: edge %q to %q: node %w: %q", p.source, p.target, graph.ErrNotExists, p.target)
+		}
+		edges[i] = graph.NewEdge(start, end, p.weight)
+		edges[i].Attributes = p.attrs
+	}
+	if err := rd.g.AddEdges(edges...); err != nil {
+		return fmt.Errorf("graphml: %w", err)
+	}
+	return nil
+}
+
+// intID retrieves the value of a canonical integer ID, one which formats back
+// to itself, so that "1" and "01" are distinct.
+func intID(id string) (int, bool) {
+	value, err := strconv.Atoi(id)
+	if err != nil || strconv.Itoa(value) != id {
+		return 0, false
+	}
+	return value, true
+}
+
+// data sets attributes from the data elements and the key defaults. If weight
+// is not nil, the data of the key named WeightKey is stored in it instead.
+func (rd *reader) data(attrs *graph.Attributes, domain string, data []xmlData, weight *float64) error {
+	set := func(k *key, s string) error {
+		if weight != nil && k.name == WeightKey {
+			w, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				return err
+			}
+			*weight = w
+			return nil
+		}
+		value, err := k.parse(s)
+		if err != nil {
+			return err
+		}
+		attrs.SetAttr(k.name, value)
+		return nil
+	}
+
+	seen := make(map[string]bool, len(data))
+	for _, d := range data {
+		k, ok := rd.keys[d.Key]
+		if !ok {
+			return fmt.Errorf("key %w: %q", graph.ErrNotExists, d.Key)
+		}
+		if k.name == "" || !k.appliesTo(domain) {
+			continue
+		}
+		if err := set(k, d.Value); err != nil {
+			return fmt.Errorf("key %q: %w", d.Key, err)
+		}
+		seen[d.Key] = true
+	}
+	for id, k := range rd.keys {
+		if seen[id] || k.def == nil || k.name == "" || !k.appliesTo(domain) {
+			continue
+		}
+		if err := set(k, *k.def); err != nil {
+			return fmt.Errorf("key %q: %w", id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,288 @@
+package graphml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sewiti/ktu-testing/pkg/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrite(t *testing.T) {
+	t.Run("should write keys, nodes and edges", func(t *testing.T) {
+		v0 := graph.NewVertex(0)
+		v1 := graph.NewVertex(1)
+		e01 := graph.NewEdge(v0, v1, 1.5)
+
+		v0.SetAttr("label", "root")
+		v1.SetAttr("size", 3)
+		v1.SetAttr("label", 7)
+		e01.SetAttr("visible", true)
+		e01.SetAttr(WeightKey, "ignored")
+
+		g := graph.NewDirected()
+		assert.NoError(t, g.AddEdges(e01))
+
+		var buf bytes.Buffer
+		assert.NoError(t, Write(&buf, g))
+		assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="edge" attr.name="weight" attr.type="double"></key>
+  <key id="d1" for="node" attr.name="label" attr.type="string"></key>
+  <key id="d2" for="node" attr.name="size" attr.type="int"></key>
+  <key id="d3" for="edge" attr.name="visible" attr.type="boolean"></key>
+  <graph id="G" edgedefault="directed">
+    <node id="0">
+      <data key="d1">root</data>
+    </node>
+    <node id="1">
+      <data key="d1">7</data>
+      <data key="d2">3</data>
+    </node>
+    <edge source="0" target="1">
+      <data key="d0">1.5</data>
+      <data key="d3">true</data>
+    </edge>
+  </graph>
+</graphml>
+`, buf.String())
+	})
+}
+
+func TestRead(t *testing.T) {
+	t.Run("should round-trip graph", func(t *testing.T) {
+		g := graph.NewUndirected(graph.AllowMultiEdges())
+		e01, err := g.AddEdgeByValue(0, 1, 1.5)
+		assert.NoError(t, err)
+		_, err = g.AddEdgeByValue(1, 0, -2)
+		assert.NoError(t, err)
+		assert.NoError(t, g.AddVertices(graph.NewVertex(7)))
+
+		v0, _ := g.Vertex(0)
+		v0.SetAttr("label", "a <b> & c")
+		v0.SetAttr("rank", int64(1)<<40)
+		v0.SetAttr("x", float32(0.5))
+		e01.SetAttr("cost", 2.25)
+
+		var buf bytes.Buffer
+		assert.NoError(t, Write(&buf, g))
+
+		decoded, err := Read(&buf, graph.AllowMultiEdges())
+		assert.NoError(t, err)
+		assert.True(t, g.Equal(decoded))
+		assert.Equal(t, g.String(), decoded.String())
+		assert.False(t, decoded.IsDirected())
+
+		w0, _ := decoded.Vertex(0)
+		assert.Equal(t, v0.Attrs(), w0.Attrs())
+		assert.Equal(t, e01.Attrs(), decoded.GetEdges()[0].Attrs())
+		assert.Empty(t, decoded.GetEdges()[1].Attrs())
+	})
+
+	t.Run("should read yEd document", func(t *testing.T) {
+		src := `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns" xmlns:y="http://www.yworks.com/xml/graphml">
+  <key for="node" id="d0" yfiles.type="nodegraphics"/>
+  <key attr.name="description" attr.type="string" for="node" id="d1"/>
+  <key attr.name="weight" attr.type="double" for="edge" id="d2">
+    <default>1</default>
+  </key>
+  <key attr.name="color" attr.type="string" for="all" id="d3">
+    <default>black</default>
+  </key>
+  <graph edgedefault="directed" id="G">
+    <data key="d1">Graph data is ignored</data>
+    <node id="n0">
+      <data key="d0">
+        <y:ShapeNode><y:NodeLabel>Start</y:NodeLabel></y:ShapeNode>
+      </data>
+      <data key="d1">first</data>
+    </node>
+    <node id="1"/>
+    <node id="n2">
+      <port name="north"/>
+      <data key="d3">red</data>
+    </node>
+    <edge id="e0" source="n0" target="1">
+      <data key="d2">2.5</data>
+    </edge>
+    <edge id="e1" source="1" target="n2" directed="true"/>
+    <hyperedge>
+      <endpoint node="n0"/>
+      <endpoint node="1"/>
+    </hyperedge>
+  </graph>
+</graphml>`
+		g, err := Read(strings.NewReader(src))
+		assert.NoError(t, err)
+		assert.True(t, g.IsDirected())
+		assert.Equal(t, "0 1 2", g.String())
+
+		v0, _ := g.Vertex(0)
+		assert.Equal(t, map[string]any{IDAttr: "n0", "description": "first", "color": "black"}, v0.Attrs())
+		v1, _ := g.Vertex(1)
+		assert.Equal(t, map[string]any{"color": "black"}, v1.Attrs())
+		v2, _ := g.Vertex(2)
+		assert.Equal(t, map[string]any{IDAttr: "n2", "color": "red"}, v2.Attrs())
+
+		edges := g.GetEdges()
+		assert.Len(t, edges, 2)
+		assert.Equal(t, float64(2.5), edges[0].Weight)
+		assert.Equal(t, float64(1), edges[1].Weight)
+		assert.Equal(t, map[string]any{"color": "black"}, edges[1].Attrs())
+	})
+
+	t.Run("should stream large document", func(t *testing.T) {
+		const n = 20000
+
+		r, w := io.Pipe()
+		go func() {
+			fmt.Fprint(w, `<graphml><key id="w" for="edge" attr.name="weight" attr.type="double"/><graph edgedefault="undirected">`)
+			for i := 0; i < n; i++ {
+				fmt.Fprintf(w, `<node id="%d"/>`, i)
+			}
+			for i := 1; i < n; i++ {
+				fmt.Fprintf(w, `<edge source="%d" target="%d"><data key="w">%d</data></edge>`, i-1, i, i)
+			}
+			fmt.Fprint(w, `</graph></graphml>`)
+			w.Close()
+		}()
+
+		g, err := Read(r)
+		assert.NoError(t, err)
+		assert.Len(t, g.GetVertices(), n)
+		assert.Len(t, g.GetEdges(), n-1)
+		assert.Equal(t, float64(n*(n-1)/2), g.GetWeight())
+	})
+
+	t.Run("should not assign values of later integer IDs", func(t *testing.T) {
+		src := `<graphml><graph edgedefault="directed">
+  <node id="a"/><node id="0"/><node id="01"/><node id="1"/>
+  <edge source="a" target="01"/>
+</graph></graphml>`
+		g, err := Read(strings.NewReader(src))
+		assert.NoError(t, err)
+		assert.Equal(t, "2 0 3 1", g.String())
+		a, _ := g.Vertex(2)
+		v01, _ := g.Vertex(3)
+		assert.Len(t, g.FindEdges(a, v01), 1)
+
+		for value, id := range map[int]any{2: "a", 3: "01"} {
+			v, _ := g.Vertex(value)
+			got, _ := v.Attr(IDAttr)
+			assert.Equal(t, id, got)
+		}
+		for _, value := range []int{0, 1} {
+			v, _ := g.Vertex(value)
+			_, ok := v.Attr(IDAttr)
+			assert.False(t, ok)
+		}
+	})
+
+	t.Run("should keep id key apart from node ID", func(t *testing.T) {
+		src := `<graphml>
+  <key id="k" for="node" attr.name="id" attr.type="string"/>
+  <graph edgedefault="directed"><node id="n0"><data key="k">label</data></node></graph>
+</graphml>`
+		g, err := Read(strings.NewReader(src))
+		assert.NoError(t, err)
+
+		v0, _ := g.Vertex(0)
+		assert.Equal(t, map[string]any{"id": "label", IDAttr: "n0"}, v0.Attrs())
+	})
+
+	t.Run("should reject node with data of its ID attribute", func(t *testing.T) {
+		src := `<graphml>
+  <key id="k" for="node" attr.name="graphml:id" attr.type="string"/>
+  <graph edgedefault="directed"><node id="1"><data key="k">a</data></node><node id="n0"><data key="k">b</data></node></graph>
+</graphml>`
+		g, err := Read(strings.NewReader(src))
+		assert.Nil(t, g)
+		assert.ErrorIs(t, err, graph.ErrExists)
+		assert.Contains(t, err.Error(), `node "n0"`)
+	})
+
+	t.Run("should read edges before their nodes", func(t *testing.T) {
+		src := `<graphml><graph edgedefault="undirected"><edge source="0" target="1"/><node id="1"/><node id="0"/></graph></graphml>`
+		g, err := Read(strings.NewReader(src))
+		assert.NoError(t, err)
+		assert.Len(t, g.GetEdges(), 1)
+	})
+
+	tests := []struct {
+		name    string
+		src     string
+		wantErr error
+	}{
+		{
+			name:    "dangling edge",
+			src:     `<graphml><graph edgedefault="directed"><node id="a"/><edge source="a" target="b"/></graph></graphml>`,
+			wantErr: graph.ErrNotExists,
+		},
+		{
+			name:    "duplicate node",
+			src:     `<graphml><graph edgedefault="directed"><node id="a"/><node id="a"/></graph></graphml>`,
+			wantErr: graph.ErrExists,
+		},
+		{
+			name:    "undeclared key",
+			src:     `<graphml><graph edgedefault="directed"><node id="a"><data key="x">1</data></node></graph></graphml>`,
+			wantErr: graph.ErrNotExists,
+		},
+		{
+			name:    "parallel edge",
+			src:     `<graphml><graph edgedefault="undirected"><node id="a"/><node id="b"/><edge source="a" target="b"/><edge source="b" target="a"/></graph></graphml>`,
+			wantErr: graph.ErrParallelEdge,
+		},
+		{
+			name:    "mixed directions",
+			src:     `<graphml><graph edgedefault="undirected"><node id="a"/><node id="b"/><edge source="a" target="b" directed="true"/></graph></graphml>`,
+			wantErr: ErrUnsupported,
+		},
+		{
+			name:    "nested graph",
+			src:     `<graphml><graph edgedefault="directed"><node id="a"><graph edgedefault="directed"/></node></graph></graphml>`,
+			wantErr: ErrUnsupported,
+		},
+		{
+			name:    "multiple graphs",
+			src:     `<graphml><graph edgedefault="directed"/><graph edgedefault="directed"/></graphml>`,
+			wantErr: ErrUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			g, err := Read(strings.NewReader(tt.src))
+			assert.Nil(t, g)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("should reject invalid data value", func(t *testing.T) {
+		src := `<graphml>
+  <key id="d0" for="node" attr.name="size" attr.type="int"/>
+  <graph edgedefault="directed"><node id="a"><data key="d0">big</data></node></graph>
+</graphml>`
+		_, err := Read(strings.NewReader(src))
+		assert.EqualError(t, err, `graphml: node "a": key "d0": strconv.Atoi: parsing "big": invalid syntax`)
+	})
+
+	t.Run("should reject malformed documents", func(t *testing.T) {
+		for _, src := range []string{
+			``,
+			`<graphml></graphml>`,
+			`<graphml><graph edgedefault="sideways"/></graphml>`,
+			`<graphml><graph edgedefault="directed"><node id="a">`,
+		} {
+			g, err := Read(strings.NewReader(src))
+			assert.Nil(t, g)
+			assert.Error(t, err, src)
+			assert.False(t, errors.Is(err, ErrUnsupported))
+		}
+	})
+}
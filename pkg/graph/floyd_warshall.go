@@ -0,0 +1,52 @@
+package graph
+
+import "fmt"
+
+// FloydWarshall finds shortest paths between every pair of vertices using
+// Floyd-Warshall algorithm over the lightest edges between them. Negative
+// weights are allowed.
+//
+// Returns an error matching ErrNegativeCycle if the Graph contains a negative
+// cycle.
+func FloydWarshall[K comparable, V any, W Number](g *GraphOf[K, V, W]) (*AllPathsOf[K, V, W], error) {
+	inf := maxWeight[W]()
+
+	p := newAllPaths(g)
+	for i, v := range g.vertices {
+		for _, w := range v.GetNeighbors() {
+			j := p.indices[w]
+			p.hops[i][j] = g.lightestEdge(v, w)
+			p.dist[i][j] = p.hops[i][j].Weight
+			p.next[i][j] = j
+		}
+		if p.dist[i][i] > 0 {
+			p.dist[i][i] = 0
+		}
+		p.next[i][i] = i
+	}
+
+	n := len(g.vertices)
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if p.dist[i][k] == inf {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if p.dist[k][j] == inf { // Avoid summing the sentinel
+					continue
+				}
+				if d := p.dist[i][k] + p.dist[k][j]; d < p.dist[i][j] {
+					p.dist[i][j] = d
+					p.next[i][j] = p.next[i][k]
+				}
+			}
+		}
+	}
+
+	for i, v := range g.vertices {
+		if p.dist[i][i] < 0 {
+			return nil, fmt.Errorf("%w through vertex %s", ErrNegativeCycle, v)
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,155 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloydWarshall(t *testing.T) {
+	t.Run("should find all pairs shortest paths in directed graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+
+		e01 := NewEdge(v0, v1, 3)
+		e12 := NewEdge(v1, v2, -2)
+		e02 := NewEdge(v0, v2, 2)
+		e23 := NewEdge(v2, v3, 1)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e01, e12, e02, e23))
+
+		p, err := FloydWarshall(g)
+		assert.NoError(t, err)
+
+		const inf = math.MaxFloat64
+		expected := [][]float64{
+			{0, 3, 1, 2},
+			{inf, 0, -2, -1},
+			{inf, inf, 0, 1},
+			{inf, inf, inf, 0},
+		}
+		assert.Equal(t, expected, p.Distances())
+
+		expectedNext := [][]int{
+			{0, 1, 1, 1},
+			{-1, 1, 2, 2},
+			{-1, -1, 2, 3},
+			{-1, -1, -1, 3},
+		}
+		assert.Equal(t, expectedNext, p.NextHops())
+
+		assert.Equal(t, float64(2), p.DistanceBetween(v0, v3))
+		assert.Equal(t, []*Edge{e01, e12, e23}, p.PathBetween(v0, v3))
+		assert.Nil(t, p.PathBetween(v3, v0))
+		assert.Empty(t, p.PathBetween(v1, v1))
+	})
+
+	t.Run("should find all pairs shortest paths in undirected graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 1)
+		e12 := NewEdge(v1, v2, 1)
+		e02 := NewEdge(v0, v2, 5)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(e01, e12, e02))
+
+		p, err := FloydWarshall(g)
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(2), p.DistanceBetween(v2, v0))
+		assert.Equal(t, []*Edge{e12, e01}, p.PathBetween(v2, v0))
+	})
+
+	t.Run("should not overflow on disconnected vertices with huge weights", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(NewEdge(v0, v1, math.MaxFloat64/2)))
+		assert.NoError(t, g.AddVertices(v2))
+
+		p, err := FloydWarshall(g)
+		assert.NoError(t, err)
+
+		assert.Equal(t, math.MaxFloat64/2, p.DistanceBetween(v0, v1))
+		assert.Equal(t, math.MaxFloat64, p.DistanceBetween(v0, v2))
+		assert.Equal(t, math.MaxFloat64, p.DistanceBetween(v1, v0))
+	})
+
+	t.Run("should detect negative cycle", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 1),
+			NewEdge(v1, v2, -3),
+			NewEdge(v2, v0, 1),
+		))
+
+		p, err := FloydWarshall(g)
+		assert.Nil(t, p)
+		assert.ErrorIs(t, err, ErrNegativeCycle)
+	})
+
+	t.Run("should use lightest of parallel edges", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		e01 := NewEdge(v0, v1, 5)
+		e01b := NewEdge(v0, v1, 1)
+
+		g := NewDirected(AllowMultiEdges())
+		assert.NoError(t, g.AddEdges(e01, e01b))
+
+		p, err := FloydWarshall(g)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(1), p.DistanceBetween(v0, v1))
+		assert.Equal(t, []*Edge{e01b}, p.PathBetween(v0, v1))
+
+		q, err := Johnson(g, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, p.Distances(), q.Distances())
+		assert.Equal(t, p.PathBetween(v0, v1), q.PathBetween(v0, v1))
+	})
+
+	t.Run("should handle empty graph", func(t *testing.T) {
+		p, err := FloydWarshall(NewDirected())
+		assert.NoError(t, err)
+		assert.Empty(t, p.Distances())
+		assert.Equal(t, math.MaxFloat64, p.DistanceBetween(NewVertex(0), NewVertex(1)))
+	})
+
+	t.Run("should find paths in generic graph", func(t *testing.T) {
+		g := NewDirectedOf[string, struct{}, int]()
+		for _, e := range []struct {
+			from, to string
+			weight   int
+		}{{"a", "b", 4}, {"b", "c", -1}, {"a", "c", 5}} {
+			_, err := g.AddEdgeByValue(e.from, e.to, e.weight)
+			assert.NoError(t, err)
+		}
+		a, _ := g.Vertex("a")
+		c, _ := g.Vertex("c")
+
+		p, err := FloydWarshall(g)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, p.DistanceBetween(a, c))
+		assert.Equal(t, math.MaxInt, p.DistanceBetween(c, a))
+		assert.Len(t, p.PathBetween(a, c), 2)
+
+		q, err := Johnson(g, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, p.Distances(), q.Distances())
+		assert.Equal(t, p.PathBetween(a, c), q.PathBetween(a, c))
+	})
+}
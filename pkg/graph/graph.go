@@ -14,109 +14,236 @@ var (
 
 	// ErrNotExists reports that the object does not exist.
 	ErrNotExists = errors.New("does not exist")
+
+	// ErrNegativeWeight reports that the edge weight is negative where
+	// negative weights are not supported.
+	ErrNegativeWeight = errors.New("has negative weight")
+
+	// ErrNegativeCycle reports that the graph contains a cycle with a negative
+	// total weight.
+	ErrNegativeCycle = errors.New("negative cycle")
+
+	// ErrInadmissibleHeuristic reports that the heuristic overestimated the
+	// remaining path weight.
+	ErrInadmissibleHeuristic = errors.New("inadmissible heuristic")
+
+	// ErrDirected reports that the operation is not supported on a directed
+	// graph.
+	ErrDirected = errors.New("graph is directed")
+
+	// ErrUndirected reports that the operation is not supported on an
+	// undirected graph.
+	ErrUndirected = errors.New("graph is undirected")
+
+	// ErrCycle reports that the graph contains a cycle where it must be
+	// acyclic.
+	ErrCycle = errors.New("cycle")
+
+	// ErrParallelEdge reports that the edge connects already connected
+	// vertices in a graph which doesn't allow multi-edges.
+	ErrParallelEdge = errors.New("is parallel to an existing edge")
+
+	// ErrSelfLoop reports that the edge connects a vertex to itself in a graph
+	// which doesn't allow self-loops.
+	ErrSelfLoop = errors.New("is a self-loop")
 )
 
+// Number is a constraint permitting any numeric type usable as an edge weight.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
 // Graph represents a set of vertices and connections between them.
-type Graph struct {
+type Graph = GraphOf[int, struct{}, float64]
+
+// GraphOf represents a set of vertices identified by values of type K,
+// carrying data of type V and connected by edges weighing W. The zero value
+// is an empty undirected graph with default options.
+//
+// The algorithms of this package accept any GraphOf, weighted ones compute
+// distances in W. Besides JSON, the encodings (DOT and the encoding
+// subpackages) support Graph only.
+type GraphOf[K comparable, V any, W Number] struct {
+	options
 	directed bool
-	edges    []*Edge
-	vertices []*Vertex
+	edges    []*EdgeOf[K, V, W]
+	vertices []*VertexOf[K, V, W]
+	byValue  map[K]*VertexOf[K, V, W]
+}
+
+type options struct {
+	multiEdges bool
+	selfLoops  bool
+}
+
+// Option configures a Graph.
+type Option func(*options)
+
+// AllowMultiEdges allows multiple edges connecting the same vertices. By
+// default AddEdges rejects them with ErrParallelEdge.
+func AllowMultiEdges() Option {
+	return func(o *options) {
+		o.multiEdges = true
+	}
+}
+
+// AllowSelfLoops allows edges connecting a vertex to itself. By default
+// AddEdges rejects them with ErrSelfLoop.
+func AllowSelfLoops() Option {
+	return func(o *options) {
+		o.selfLoops = true
+	}
 }
 
 // NewDirected creates a new directed Graph.
-func NewDirected() *Graph {
-	return &Graph{directed: true}
+func NewDirected(opts ...Option) *Graph {
+	return NewDirectedOf[int, struct{}, float64](opts...)
 }
 
 // NewUndirected creates a new undirected Graph.
-func NewUndirected() *Graph {
-	return &Graph{directed: false}
+func NewUndirected(opts ...Option) *Graph {
+	return NewUndirectedOf[int, struct{}, float64](opts...)
 }
 
-// AddVertices adds vertices to Graph.
-//
-// Returns first ErrExists if Vertex is a duplicate.
-func (g *Graph) AddVertices(v ...*Vertex) error {
-	for _, v := range v {
-		if err := g.addVertex(v); err != nil {
-			return err
-		}
-	}
-	return nil
+// NewDirectedOf creates a new directed GraphOf.
+func NewDirectedOf[K comparable, V any, W Number](opts ...Option) *GraphOf[K, V, W] {
+	return newGraphOf[K, V, W](true, opts)
 }
 
-func (g *Graph) addVertex(v *Vertex) error {
-	if g.vertexExists(v) {
-		return fmt.Errorf("vertex %w: %d", ErrExists, v.Value)
+// NewUndirectedOf creates a new undirected GraphOf.
+func NewUndirectedOf[K comparable, V any, W Number](opts ...Option) *GraphOf[K, V, W] {
+	return newGraphOf[K, V, W](false, opts)
+}
+
+func newGraphOf[K comparable, V any, W Number](directed bool, opts []Option) *GraphOf[K, V, W] {
+	g := &GraphOf[K, V, W]{
+		directed: directed,
+		byValue:  make(map[K]*VertexOf[K, V, W]),
 	}
-	g.vertices = append(g.vertices, v)
-	return nil
+	for _, opt := range opts {
+		opt(&g.options)
+	}
+	return g
 }
 
-// AddEdges adds edges to Graph, also attaching itself to vertices.
+// AddVertices adds vertices to Graph. Vertex values must be unique within the
+// Graph and must not be changed while the Vertex is in it.
 //
-// If unknown vertices are encountered, they are also added.
+// Vertices are added atomically: if any of them fails, none are added.
 //
-// Returns first ErrExists if Edge is a duplicate.
-func (g *Graph) AddEdges(e ...*Edge) error {
-	for _, e := range e {
-		if err := g.addEdge(e); err != nil {
-			return err
-		}
+// Returns *BatchError with ErrExists for every Vertex which is a duplicate or
+// its value is taken.
+func (g *GraphOf[K, V, W]) AddVertices(v ...*VertexOf[K, V, W]) error {
+	return g.Batch(func(tx *TxOf[K, V, W]) error {
+		_ = tx.AddVertices(v...)
+		return nil
+	})
+}
+
+// Vertex retrieves a Vertex by its value.
+//
+// Reports false if Graph has no such Vertex.
+func (g *GraphOf[K, V, W]) Vertex(value K) (*VertexOf[K, V, W], bool) {
+	v, ok := g.byValue[value]
+	return v, ok
+}
+
+// DeleteVertices deletes vertices from Graph, including all of their incident
+// edges, both outgoing and incoming.
+//
+// Returns ErrNotExists if any of the vertices doesn't exist, in which case
+// nothing is deleted.
+func (g *GraphOf[K, V, W]) DeleteVertices(v ...*VertexOf[K, V, W]) error {
+	if err := g.checkVertices(v); err != nil {
+		return err
+	}
+	for _, v := range v {
+		g.deleteVertex(v)
 	}
 	return nil
 }
 
-func (g *Graph) addEdge(e *Edge) error {
-	// Check if edge exists
-	for _, edge := range g.edges {
-		if edge == e {
-			return fmt.Errorf("edge %w: %s", ErrExists, e)
+func (g *GraphOf[K, V, W]) deleteVertex(v *VertexOf[K, V, W]) {
+	edges := g.edges[:0]
+	for _, e := range g.edges {
+		if e.start != v && e.end != v {
+			edges = append(edges, e)
+			continue
 		}
-	}
-
-	// Ensure vertices exist
-	if !g.vertexExists(e.start) {
-		err := g.addVertex(e.start)
-		if err != nil {
-			return err
+		_ = e.start.DeleteEdge(e)
+		_ = e.end.deleteInEdge(e)
+		if !g.directed && e.end != e.start { // Undirected have edge both ways
+			_ = e.end.DeleteEdge(e)
+			_ = e.start.deleteInEdge(e)
 		}
 	}
-	if !g.vertexExists(e.end) {
-		err := g.addVertex(e.end)
-		if err != nil {
-			return err
+	for i := len(edges); i < len(g.edges); i++ {
+		g.edges[i] = nil // Release deleted edges
+	}
+	g.edges = edges
+
+	for i, w := range g.vertices {
+		if w == v {
+			g.vertices = append(g.vertices[:i], g.vertices[i+1:]...)
+			break
 		}
 	}
+	delete(g.byValue, v.Value)
+}
 
-	// Add edge
-	g.edges = append(g.edges, e)
+// AddEdges adds edges to Graph, also attaching itself to vertices.
+//
+// If unknown vertices are encountered, they are also added.
+//
+// Edges are added atomically: if any of them fails, none of the edges nor the
+// vertices are added.
+//
+// Returns *BatchError with ErrExists for every Edge which is a duplicate,
+// ErrParallelEdge or ErrSelfLoop if Edge is not allowed by the Graph options.
+func (g *GraphOf[K, V, W]) AddEdges(e ...*EdgeOf[K, V, W]) error {
+	return g.Batch(func(tx *TxOf[K, V, W]) error {
+		_ = tx.AddEdges(e...)
+		return nil
+	})
+}
 
-	err := e.start.addEdge(e)
-	if err != nil {
+// AddEdgeByValue adds an edge between vertices of the values given, adding
+// new vertices for the values not in Graph yet.
+func (g *GraphOf[K, V, W]) AddEdgeByValue(from, to K, weight W) (*EdgeOf[K, V, W], error) {
+	var e *EdgeOf[K, V, W]
+	err := g.Batch(func(tx *TxOf[K, V, W]) error {
+		var err error
+		e, err = tx.AddEdgeByValue(from, to, weight)
 		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	if !g.directed { // Undirected have edge both ways
-		err = e.end.addEdge(e)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return e, nil
 }
 
 // DeleteEdge deletes an edge, including deleting it from associated vertices.
 //
 // Returns ErrNotExists if edge doesn't exist.
-func (g *Graph) DeleteEdge(e *Edge) error {
+func (g *GraphOf[K, V, W]) DeleteEdge(e *EdgeOf[K, V, W]) error {
 	for i, v := range g.edges {
 		if v == e {
 			err := e.start.DeleteEdge(e)
 			if err != nil {
 				return err
 			}
-			if !g.directed { // Undirected have edge both ways
-				err := e.end.DeleteEdge(e)
+			err = e.end.deleteInEdge(e)
+			if err != nil {
+				return err
+			}
+			if !g.directed && e.start != e.end { // Undirected have edge both ways
+				err = e.end.DeleteEdge(e)
+				if err != nil {
+					return err
+				}
+				err = e.start.deleteInEdge(e)
 				if err != nil {
 					return err
 				}
@@ -128,39 +255,97 @@ func (g *Graph) DeleteEdge(e *Edge) error {
 	return fmt.Errorf("edge %w: %s", ErrNotExists, e)
 }
 
-// FindEdge retrieves an edge which connects the vertices given.
+// FindEdge retrieves an edge which connects the vertices given. If there are
+// multiple, the first one added is retrieved.
 //
 // Returns nil if doesn't exist.
-func (g *Graph) FindEdge(start, end *Vertex) *Edge {
-	if !g.vertexExists(start) {
+func (g *GraphOf[K, V, W]) FindEdge(start, end *VertexOf[K, V, W]) *EdgeOf[K, V, W] {
+	if !g.vertexExists(start) || !start.HasNeighbor(end) {
 		return nil
 	}
-	return start.FindEdge(end)
+	for _, e := range start.edges {
+		if g.connects(e, start, end) {
+			return e
+		}
+	}
+	return nil
+}
+
+// FindEdges retrieves all edges which connect the vertices given, useful when
+// multi-edges are allowed.
+func (g *GraphOf[K, V, W]) FindEdges(start, end *VertexOf[K, V, W]) []*EdgeOf[K, V, W] {
+	if !g.vertexExists(start) || !start.HasNeighbor(end) {
+		return nil
+	}
+	var edges []*EdgeOf[K, V, W]
+	for _, e := range start.edges {
+		if g.connects(e, start, end) {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// lightestEdge retrieves the lightest edge which connects the vertices given.
+// If there are multiple, the first one added is retrieved.
+//
+// Returns nil if doesn't exist.
+func (g *GraphOf[K, V, W]) lightestEdge(start, end *VertexOf[K, V, W]) *EdgeOf[K, V, W] {
+	var lightest *EdgeOf[K, V, W]
+	for _, e := range g.FindEdges(start, end) {
+		if lightest == nil || e.Weight < lightest.Weight {
+			lightest = e
+		}
+	}
+	return lightest
+}
+
+// connects reports whether the edge goes from start to end, in undirected
+// graphs it may go the other way too.
+func (g *GraphOf[K, V, W]) connects(e *EdgeOf[K, V, W], start, end *VertexOf[K, V, W]) bool {
+	if e.start == start && e.end == end {
+		return true
+	}
+	return !g.directed && e.start == end && e.end == start
 }
 
 // GetWeight retrieves a sum of all edges weights.
-func (g *Graph) GetWeight() float64 {
-	weight := float64(0)
+func (g *GraphOf[K, V, W]) GetWeight() W {
+	var weight W
 	for _, edge := range g.edges {
 		weight += edge.Weight
 	}
 	return weight
 }
 
-// Reverse reverses all Graph's edges.
-func (g *Graph) Reverse() {
+// Reverse reverses all Graph's edges. Undirected graphs are left intact, as
+// their edges already go both ways.
+func (g *GraphOf[K, V, W]) Reverse() {
+	if !g.directed {
+		return
+	}
 	for _, e := range g.edges {
 		_ = e.start.DeleteEdge(e)
 		_ = e.end.addEdge(e)
+		_ = e.end.deleteInEdge(e)
+		_ = e.start.addInEdge(e)
 		e.Reverse()
 	}
 }
 
+// Transpose creates a copy of the Graph with all edges reversed, leaving the
+// Graph itself intact. For undirected graphs it is the same as Clone.
+func (g *GraphOf[K, V, W]) Transpose() *GraphOf[K, V, W] {
+	t, _ := g.Clone()
+	t.Reverse()
+	return t
+}
+
 // GetAdjacencyMatrix retrieves an adjacency matrix between each Vertex indices.
-// The value is edge weight.
+// The value is edge weight, the lightest one if there are multiple edges.
 //
 // If vertices aren't connected, value is set to math.MaxFloat64.
-func (g *Graph) GetAdjacencyMatrix() [][]float64 {
+func (g *GraphOf[K, V, W]) GetAdjacencyMatrix() [][]float64 {
 	const inf = math.MaxFloat64
 
 	adjacency := make([][]float64, len(g.vertices))
@@ -175,8 +360,8 @@ func (g *Graph) GetAdjacencyMatrix() [][]float64 {
 	for i, v := range g.vertices {
 		for _, neighbor := range v.GetNeighbors() {
 			ni := indices[neighbor]
-			edge := g.FindEdge(v, neighbor)
-			adjacency[i][ni] = edge.Weight
+			edge := g.lightestEdge(v, neighbor)
+			adjacency[i][ni] = float64(edge.Weight)
 		}
 	}
 	return adjacency
@@ -184,7 +369,7 @@ func (g *Graph) GetAdjacencyMatrix() [][]float64 {
 
 // String retrieves a string representation of the Graph: vertices values
 // separated by space.
-func (g *Graph) String() string {
+func (g *GraphOf[K, V, W]) String() string {
 	keys := make([]int, 0, len(g.vertices))
 	for i := range g.vertices {
 		keys = append(keys, i)
@@ -203,9 +388,9 @@ func (g *Graph) String() string {
 
 // GetVerticesIndices retrieves a map of the vertices indices.
 //
-// Key is *Vertex, value is it's index in the Graph.
-func (g *Graph) GetVerticesIndices() map[*Vertex]int {
-	indices := make(map[*Vertex]int)
+// Key is *VertexOf[K, V, W], value is it's index in the Graph.
+func (g *GraphOf[K, V, W]) GetVerticesIndices() map[*VertexOf[K, V, W]]int {
+	indices := make(map[*VertexOf[K, V, W]]int)
 	for i, v := range g.vertices {
 		indices[v] = i
 	}
@@ -213,20 +398,38 @@ func (g *Graph) GetVerticesIndices() map[*Vertex]int {
 }
 
 // GetVertices retrieves all Graph's vertices.
-func (g *Graph) GetVertices() []*Vertex {
+func (g *GraphOf[K, V, W]) GetVertices() []*VertexOf[K, V, W] {
 	return g.vertices
 }
 
+// IsDirected reports whether the Graph is directed.
+func (g *GraphOf[K, V, W]) IsDirected() bool {
+	return g.directed
+}
+
 // GetEdges retrieves all Graph's edges.
-func (g *Graph) GetEdges() []*Edge {
+func (g *GraphOf[K, V, W]) GetEdges() []*EdgeOf[K, V, W] {
 	return g.edges
 }
 
-func (g *Graph) vertexExists(v *Vertex) bool {
-	for _, vertex := range g.vertices {
-		if vertex == v {
+func (g *GraphOf[K, V, W]) edgeExists(e *EdgeOf[K, V, W]) bool {
+	for _, edge := range g.edges {
+		if edge == e {
 			return true
 		}
 	}
 	return false
 }
+
+func (g *GraphOf[K, V, W]) vertexExists(v *VertexOf[K, V, W]) bool {
+	return v != nil && g.byValue[v.Value] == v
+}
+
+func (g *GraphOf[K, V, W]) checkVertices(vertices []*VertexOf[K, V, W]) error {
+	for _, v := range vertices {
+		if !g.vertexExists(v) {
+			return fmt.Errorf("vertex %w: %s", ErrNotExists, v)
+		}
+	}
+	return nil
+}
@@ -18,6 +18,80 @@ func TestGraph(t *testing.T) {
 		assert.Equal(t, "0 1", g.String())
 	})
 
+	t.Run("should throw an error when adding vertex with taken value", func(t *testing.T) {
+		g := NewUndirected()
+
+		assert.NoError(t, g.AddVertices(NewVertex(1)))
+		assert.ErrorIs(t, g.AddVertices(NewVertex(1)), ErrExists)
+		assert.Len(t, g.GetVertices(), 1)
+	})
+
+	t.Run("should use zero value as undirected graph", func(t *testing.T) {
+		var g Graph
+
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		assert.NoError(t, g.AddVertices(v0))
+		assert.NoError(t, g.AddEdges(NewEdge(v0, v1, 1)))
+
+		assert.False(t, g.IsDirected())
+		assert.Equal(t, "0 1", g.String())
+		v, ok := g.Vertex(1)
+		assert.True(t, ok)
+		assert.Same(t, v1, v)
+		assert.Equal(t, []*Vertex{v0}, v1.GetNeighbors())
+
+		var empty Graph
+		_, ok = empty.Vertex(0)
+		assert.False(t, ok)
+	})
+
+	t.Run("should report whether graph is directed", func(t *testing.T) {
+		assert.True(t, NewDirected().IsDirected())
+		assert.False(t, NewUndirected().IsDirected())
+	})
+
+	t.Run("should find vertex by value", func(t *testing.T) {
+		g := NewUndirected()
+
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		assert.NoError(t, g.AddVertices(v0, v1))
+
+		v, ok := g.Vertex(1)
+		assert.True(t, ok)
+		assert.Equal(t, v1, v)
+
+		v, ok = g.Vertex(2)
+		assert.False(t, ok)
+		assert.Nil(t, v)
+
+		assert.NoError(t, g.DeleteVertices(v1))
+		_, ok = g.Vertex(1)
+		assert.False(t, ok)
+	})
+
+	t.Run("should add edges by vertex values", func(t *testing.T) {
+		g := NewDirected(AllowSelfLoops())
+
+		v0 := NewVertex(0)
+		assert.NoError(t, g.AddVertices(v0))
+
+		e01, err := g.AddEdgeByValue(0, 1, 5)
+		assert.NoError(t, err)
+		e11, err := g.AddEdgeByValue(1, 1, 2)
+		assert.NoError(t, err)
+
+		v1, ok := g.Vertex(1)
+		assert.True(t, ok)
+
+		assert.Equal(t, "0 1", g.String())
+		assert.Equal(t, []*Edge{e01, e11}, g.GetEdges())
+		assert.Equal(t, e01, g.FindEdge(v0, v1))
+		assert.Equal(t, float64(5), e01.Weight)
+		assert.Equal(t, e11, g.FindEdge(v1, v1))
+	})
+
 	t.Run("should add edges to undirected graph", func(t *testing.T) {
 		g := NewUndirected()
 
@@ -62,6 +136,59 @@ func TestGraph(t *testing.T) {
 		assert.Equal(t, 0, v1.GetDegree())
 	})
 
+	t.Run("should track incoming edges in directed graph", func(t *testing.T) {
+		g := NewDirected()
+
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 0)
+		e21 := NewEdge(v2, v1, 0)
+		e12 := NewEdge(v1, v2, 0)
+
+		assert.NoError(t, g.AddEdges(e01, e21, e12))
+
+		assert.Equal(t, 0, v0.InDegree())
+		assert.Equal(t, 1, v0.OutDegree())
+		assert.Equal(t, 2, v1.InDegree())
+		assert.Equal(t, 1, v1.OutDegree())
+		assert.Equal(t, []*Edge{e01, e21}, v1.InEdges())
+		assert.Equal(t, []*Vertex{v0, v2}, v1.InNeighbors())
+
+		assert.NoError(t, g.DeleteEdge(e01))
+		assert.Equal(t, []*Edge{e21}, v1.InEdges())
+
+		g.Reverse()
+		assert.Equal(t, []*Edge{e12}, v1.InEdges())
+		assert.Equal(t, []*Edge{e21}, v2.InEdges())
+		assert.Equal(t, 1, v1.OutDegree())
+
+		assert.NoError(t, g.DeleteVertices(v2))
+		assert.Empty(t, v1.InEdges())
+		assert.Equal(t, 0, v1.OutDegree())
+	})
+
+	t.Run("should track incoming edges in undirected graph", func(t *testing.T) {
+		g := NewUndirected()
+
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		e01 := NewEdge(v0, v1, 0)
+
+		assert.NoError(t, g.AddEdges(e01))
+
+		assert.Equal(t, v0.GetEdges(), v0.InEdges())
+		assert.Equal(t, v1.GetEdges(), v1.InEdges())
+		assert.Equal(t, []*Vertex{v1}, v0.InNeighbors())
+		assert.Equal(t, 1, v1.InDegree())
+
+		assert.NoError(t, g.DeleteEdge(e01))
+		assert.Equal(t, 0, v0.InDegree())
+		assert.Equal(t, 0, v1.InDegree())
+	})
+
 	t.Run("should find edge by vertices in undirected graph", func(t *testing.T) {
 		g := NewUndirected()
 
@@ -139,6 +266,86 @@ func TestGraph(t *testing.T) {
 		assert.Error(t, g.AddEdges(e01))
 	})
 
+	t.Run("should throw an error when adding parallel edges by default", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(NewEdge(v0, v1, 0)))
+		assert.ErrorIs(t, g.AddEdges(NewEdge(v0, v1, 1)), ErrParallelEdge)
+		assert.ErrorIs(t, g.AddEdges(NewEdge(v1, v0, 1)), ErrParallelEdge)
+		assert.Len(t, g.GetEdges(), 1)
+
+		d := NewDirected()
+		assert.NoError(t, d.AddEdges(NewEdge(v0, v1, 0)))
+		assert.NoError(t, d.AddEdges(NewEdge(v1, v0, 0)))
+		assert.ErrorIs(t, d.AddEdges(NewEdge(v0, v1, 1)), ErrParallelEdge)
+	})
+
+	t.Run("should throw an error when adding self-loops by default", func(t *testing.T) {
+		v0 := NewVertex(0)
+
+		g := NewDirected()
+		assert.ErrorIs(t, g.AddEdges(NewEdge(v0, v0, 0)), ErrSelfLoop)
+		assert.Empty(t, g.GetEdges())
+		assert.Empty(t, g.GetVertices())
+	})
+
+	t.Run("should allow multi-edges when enabled", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 1)
+		e10 := NewEdge(v1, v0, 2)
+		e01b := NewEdge(v0, v1, 3)
+
+		g := NewUndirected(AllowMultiEdges())
+		assert.NoError(t, g.AddEdges(e01, e10, e01b))
+
+		assert.Equal(t, e01, g.FindEdge(v1, v0))
+		assert.Equal(t, []*Edge{e01, e10, e01b}, g.FindEdges(v0, v1))
+		assert.Equal(t, []*Edge{e01, e10, e01b}, g.FindEdges(v1, v0))
+		assert.Empty(t, g.FindEdges(v0, v2))
+
+	})
+
+	t.Run("should find directed multi-edges", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		e01 := NewEdge(v0, v1, 1)
+		e10 := NewEdge(v1, v0, 2)
+		e01b := NewEdge(v0, v1, 3)
+
+		g := NewDirected(AllowMultiEdges())
+		assert.NoError(t, g.AddEdges(e01, e10, e01b))
+
+		assert.Equal(t, []*Edge{e01, e01b}, g.FindEdges(v0, v1))
+		assert.Equal(t, []*Edge{e10}, g.FindEdges(v1, v0))
+	})
+
+	t.Run("should allow self-loops when enabled", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		e00 := NewEdge(v0, v0, 1)
+		e01 := NewEdge(v0, v1, 1)
+
+		g := NewUndirected(AllowSelfLoops())
+		assert.NoError(t, g.AddEdges(e00, e01))
+
+		assert.Equal(t, []*Edge{e00, e01}, v0.GetEdges())
+		assert.Equal(t, []*Edge{e00, e01}, v0.InEdges())
+		assert.Equal(t, []*Vertex{v0, v1}, v0.GetNeighbors())
+		assert.Equal(t, e00, g.FindEdge(v0, v0))
+		assert.Equal(t, e01, g.FindEdge(v0, v1))
+
+		assert.NoError(t, g.DeleteEdge(e00))
+		assert.Equal(t, []*Edge{e01}, v0.GetEdges())
+		assert.Equal(t, []*Edge{e01}, v0.InEdges())
+	})
+
 	t.Run("should return the list of all added edges", func(t *testing.T) {
 		g := NewDirected()
 
@@ -228,6 +435,63 @@ func TestGraph(t *testing.T) {
 
 	})
 
+	t.Run("should be possible to delete vertices from undirected graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 0)
+		e12 := NewEdge(v1, v2, 0)
+		e02 := NewEdge(v0, v2, 0)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(e01, e12, e02))
+
+		assert.NoError(t, g.DeleteVertices(v1))
+
+		assert.Equal(t, "0 2", g.String())
+		assert.Equal(t, []*Edge{e02}, g.GetEdges())
+		assert.Equal(t, []*Edge{e02}, v0.GetEdges())
+		assert.Equal(t, []*Edge{e02}, v2.GetEdges())
+	})
+
+	t.Run("should delete incoming edges when deleting vertex from directed graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 1)
+		e12 := NewEdge(v1, v2, 2)
+		e20 := NewEdge(v2, v0, 3)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e01, e12, e20))
+
+		assert.NoError(t, g.DeleteVertices(v0))
+
+		assert.Equal(t, "1 2", g.String())
+		assert.Equal(t, []*Edge{e12}, g.GetEdges())
+		assert.Equal(t, 0, v2.GetDegree())
+
+		const inf = math.MaxFloat64
+		expected := [][]float64{
+			{inf, 2},
+			{inf, inf},
+		}
+		assert.Equal(t, expected, g.GetAdjacencyMatrix())
+	})
+
+	t.Run("should throw an error when trying to delete not existing vertex", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddVertices(v0))
+
+		assert.ErrorIs(t, g.DeleteVertices(v0, v1), ErrNotExists)
+		assert.Equal(t, "0", g.String())
+	})
+
 	t.Run("should be possible to Reverse graph", func(t *testing.T) {
 		v0 := NewVertex(0)
 		v1 := NewVertex(1)
@@ -264,6 +528,67 @@ func TestGraph(t *testing.T) {
 		assert.Equal(t, v2, v3.GetNeighbors()[0])
 	})
 
+	t.Run("should leave undirected graph intact on Reverse", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 0)
+		e12 := NewEdge(v1, v2, 0)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(e01, e12))
+
+		g.Reverse()
+
+		assert.Equal(t, "0 to 1", e01.String())
+		assert.Equal(t, "1 to 2", e12.String())
+		assert.Equal(t, []*Edge{e01}, v0.GetEdges())
+		assert.Equal(t, []*Edge{e01, e12}, v1.GetEdges())
+		assert.Equal(t, []*Edge{e12}, v2.GetEdges())
+		assert.Equal(t, []*Edge{e01, e12}, v1.InEdges())
+		assert.Equal(t, e01, g.FindEdge(v1, v0))
+	})
+
+	t.Run("should transpose directed graph without modifying it", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 1)
+		e12 := NewEdge(v1, v2, 2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e01, e12))
+
+		tr := g.Transpose()
+
+		assert.Equal(t, "0 to 1", e01.String())
+		assert.Equal(t, []*Vertex{v1}, v0.GetNeighbors())
+
+		assert.Equal(t, "0 1 2", tr.String())
+		assert.Len(t, tr.GetEdges(), 2)
+		assert.Equal(t, "1 to 0", tr.GetEdges()[0].String())
+		assert.Equal(t, "2 to 1", tr.GetEdges()[1].String())
+
+		t0, _ := tr.Vertex(0)
+		t1, _ := tr.Vertex(1)
+		assert.Equal(t, 0, t0.OutDegree())
+		assert.Equal(t, 1, t0.InDegree())
+		assert.Equal(t, []*Vertex{t0}, t1.GetNeighbors())
+
+		g.Reverse()
+		assert.True(t, g.Equal(tr))
+	})
+
+	t.Run("should transpose undirected graph into a copy", func(t *testing.T) {
+		g := NewUndirected()
+		_, err := g.AddEdgeByValue(0, 1, 1)
+		assert.NoError(t, err)
+
+		assert.True(t, g.Equal(g.Transpose()))
+	})
+
 	t.Run("should return vertices indices", func(t *testing.T) {
 		v0 := NewVertex(0)
 		v1 := NewVertex(1)
@@ -337,10 +662,25 @@ func TestGraph(t *testing.T) {
 		}
 		assert.Equal(t, expected, adjacency)
 	})
+
+	t.Run("should use lightest of parallel edges in adjacency matrix", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		g := NewUndirected(AllowMultiEdges())
+		assert.NoError(t, g.AddEdges(NewEdge(v0, v1, 5), NewEdge(v1, v0, 1), NewEdge(v0, v1, 3)))
+
+		const inf = math.MaxFloat64
+		expected := [][]float64{
+			{inf, 1},
+			{1, inf},
+		}
+		assert.Equal(t, expected, g.GetAdjacencyMatrix())
+	})
 }
 
 func TestGraph_String(t *testing.T) {
-	type graphConstructor func() *Graph
+	type graphConstructor func(...Option) *Graph
 
 	tests := []struct {
 		name         string
@@ -401,7 +741,7 @@ func TestGraph_String(t *testing.T) {
 					vertex5,
 				}
 			}(),
-			want:         "5 2 3",
+			want:         "",
 			errAssertion: assert.Error,
 		},
 	}
@@ -415,3 +755,61 @@ func TestGraph_String(t *testing.T) {
 		})
 	}
 }
+
+func TestGraphOf(t *testing.T) {
+	type service struct {
+		Owner string
+	}
+
+	t.Run("should use string values, data payload and integer weights", func(t *testing.T) {
+		g := NewDirectedOf[string, service, int]()
+
+		api := NewVertexOf[string, service, int]("api", service{Owner: "web"})
+		db := NewVertexOf[string, service, int]("db", service{Owner: "data"})
+
+		e := NewEdgeOf(api, db, 3)
+		assert.NoError(t, g.AddEdges(e))
+
+		_, err := g.AddEdgeByValue("api", "cache", 4)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "api db cache", g.String())
+		assert.Equal(t, 7, g.GetWeight())
+		assert.Equal(t, "api to db", e.String())
+		assert.Equal(t, "web", api.Data.Owner)
+
+		v, ok := g.Vertex("db")
+		assert.True(t, ok)
+		assert.Equal(t, db, v)
+		assert.Equal(t, e, g.FindEdge(api, db))
+		assert.Equal(t, 1, db.InDegree())
+
+		assert.ErrorIs(t, g.AddVertices(NewVertexOf[string, service, int]("api", service{})), ErrExists)
+	})
+
+	t.Run("should generate adjacency matrix for integer weights", func(t *testing.T) {
+		g := NewUndirectedOf[string, struct{}, int]()
+
+		_, err := g.AddEdgeByValue("a", "b", 2)
+		assert.NoError(t, err)
+
+		const inf = math.MaxFloat64
+		expected := [][]float64{
+			{inf, 2},
+			{2, inf},
+		}
+		assert.Equal(t, expected, g.GetAdjacencyMatrix())
+	})
+
+	t.Run("should be interchangeable with int graph", func(t *testing.T) {
+		var g *GraphOf[int, struct{}, float64] = NewUndirected()
+
+		v0 := NewVertexOf[int, struct{}, float64](0, struct{}{})
+		v1 := NewVertex(1)
+		assert.NoError(t, g.AddEdges(NewEdge(v0, v1, 1)))
+
+		p, err := ShortestPaths(g, v0)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(1), p.DistanceTo(v1))
+	})
+}
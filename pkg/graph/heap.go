@@ -0,0 +1,56 @@
+package graph
+
+import "container/heap"
+
+// vertexItem is a Vertex queued with its priority.
+type vertexItem[K comparable, V any, W Number, P Number] struct {
+	vertex   *VertexOf[K, V, W]
+	priority P
+}
+
+// vertexHeap is a min-heap of vertices ordered by priority. Ties are broken by
+// insertion order to keep the results deterministic.
+//
+// Decrease-key is not supported, instead the same Vertex is pushed again and
+// stale items are skipped by the caller.
+type vertexHeap[K comparable, V any, W Number, P Number] struct {
+	items []vertexItem[K, V, W, P]
+	order []int
+	seq   int
+}
+
+func (h *vertexHeap[K, V, W, P]) Len() int { return len(h.items) }
+
+func (h *vertexHeap[K, V, W, P]) Less(i, j int) bool {
+	if h.items[i].priority != h.items[j].priority {
+		return h.items[i].priority < h.items[j].priority
+	}
+	return h.order[i] < h.order[j]
+}
+
+func (h *vertexHeap[K, V, W, P]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.order[i], h.order[j] = h.order[j], h.order[i]
+}
+
+func (h *vertexHeap[K, V, W, P]) Push(x interface{}) {
+	h.items = append(h.items, x.(vertexItem[K, V, W, P]))
+	h.order = append(h.order, h.seq)
+	h.seq++
+}
+
+func (h *vertexHeap[K, V, W, P]) Pop() interface{} {
+	n := len(h.items) - 1
+	item := h.items[n]
+	h.items = h.items[:n]
+	h.order = h.order[:n]
+	return item
+}
+
+func (h *vertexHeap[K, V, W, P]) push(v *VertexOf[K, V, W], priority P) {
+	heap.Push(h, vertexItem[K, V, W, P]{vertex: v, priority: priority})
+}
+
+func (h *vertexHeap[K, V, W, P]) pop() vertexItem[K, V, W, P] {
+	return heap.Pop(h).(vertexItem[K, V, W, P])
+}
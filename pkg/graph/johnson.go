@@ -0,0 +1,107 @@
+package graph
+
+import "sync"
+
+// Johnson finds shortest paths between every pair of vertices using Johnson's
+// algorithm: edges are reweighted with Bellman-Ford potentials so that
+// Dijkstra can be run from every Vertex. Negative weights are allowed. It is
+// faster than FloydWarshall on sparse graphs.
+//
+// Dijkstra runs are spread across the given number of goroutines, if workers
+// is less than 2 they are run sequentially.
+//
+// Returns *NegativeCycleError if the Graph contains a negative cycle.
+func Johnson[K comparable, V any, W Number](g *GraphOf[K, V, W], workers int) (*AllPathsOf[K, V, W], error) {
+	// Potentials are distances from a virtual source connected to every
+	// Vertex with a zero weight edge.
+	h := &PathsOf[K, V, W]{
+		dist: make(map[*VertexOf[K, V, W]]W, len(g.vertices)),
+		prev: make(map[*VertexOf[K, V, W]]*EdgeOf[K, V, W]),
+	}
+	for _, v := range g.vertices {
+		h.dist[v] = 0
+	}
+	if err := h.bellmanFord(g); err != nil {
+		return nil, err
+	}
+
+	reweighted := func(e *EdgeOf[K, V, W], from *VertexOf[K, V, W]) W {
+		w := e.Weight + h.dist[from] - h.dist[e.Other(from)]
+		if w < 0 { // Rounding errors
+			w = 0
+		}
+		return w
+	}
+
+	p := newAllPaths(g)
+	run := func(i int) {
+		source := g.vertices[i]
+		sp := dijkstra(g, source, reweighted)
+		p.fillRow(i, sp, func(v *VertexOf[K, V, W]) W {
+			return sp.dist[v] - h.dist[source] + h.dist[v]
+		})
+	}
+
+	if workers < 2 {
+		for i := range g.vertices {
+			run(i)
+		}
+		return p, nil
+	}
+
+	sources := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range sources {
+				run(i)
+			}
+		}()
+	}
+	for i := range g.vertices {
+		sources <- i
+	}
+	close(sources)
+	wg.Wait()
+	return p, nil
+}
+
+// fillRow fills the i-th row of the matrices from the single-source paths,
+// using distance to retrieve the actual distance to each reached Vertex.
+func (p *AllPathsOf[K, V, W]) fillRow(i int, sp *PathsOf[K, V, W], distance func(v *VertexOf[K, V, W]) W) {
+	// First hop on the path to each Vertex, resolved through the predecessors.
+	hop := map[*VertexOf[K, V, W]]*VertexOf[K, V, W]{sp.source: sp.source}
+	var chain []*VertexOf[K, V, W]
+	for v := range sp.dist {
+		for u := v; ; {
+			if _, ok := hop[u]; ok {
+				break
+			}
+			chain = append(chain, u)
+			prev := sp.prev[u].Other(u)
+			if prev == sp.source {
+				hop[u] = u
+				break
+			}
+			u = prev
+		}
+		for k := len(chain) - 1; k >= 0; k-- {
+			if _, ok := hop[chain[k]]; !ok {
+				hop[chain[k]] = hop[sp.prev[chain[k]].Other(chain[k])]
+			}
+		}
+		chain = chain[:0]
+	}
+
+	for v := range sp.dist {
+		j := p.indices[v]
+		k := p.indices[hop[v]]
+		p.dist[i][j] = distance(v)
+		p.next[i][j] = k
+		if k != i {
+			p.hops[i][k] = sp.prev[hop[v]]
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJohnson(t *testing.T) {
+	newGraph := func() (*Graph, []*Vertex, []*Edge) {
+		v := []*Vertex{
+			NewVertex(0),
+			NewVertex(1),
+			NewVertex(2),
+			NewVertex(3),
+			NewVertex(4),
+		}
+		e := []*Edge{
+			NewEdge(v[0], v[1], 3),
+			NewEdge(v[0], v[2], 8),
+			NewEdge(v[1], v[3], 1),
+			NewEdge(v[2], v[1], 4),
+			NewEdge(v[3], v[0], 2),
+			NewEdge(v[3], v[2], -5),
+			NewEdge(v[0], v[4], -4),
+			NewEdge(v[4], v[3], 6),
+		}
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e...))
+		return g, v, e
+	}
+
+	tests := []struct {
+		name    string
+		workers int
+	}{
+		{name: "sequential", workers: 0},
+		{name: "single worker", workers: 1},
+		{name: "concurrent", workers: 4},
+	}
+	for _, tt := range tests {
+		t.Run("should match floyd-warshall "+tt.name, func(t *testing.T) {
+			g, _, _ := newGraph()
+
+			want, err := FloydWarshall(g)
+			assert.NoError(t, err)
+			got, err := Johnson(g, tt.workers)
+			assert.NoError(t, err)
+
+			assert.Equal(t, want.Distances(), got.Distances())
+			assert.Equal(t, want.NextHops(), got.NextHops())
+		})
+	}
+
+	t.Run("should reconstruct paths", func(t *testing.T) {
+		g, v, e := newGraph()
+
+		p, err := Johnson(g, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(-4), p.DistanceBetween(v[1], v[2]))
+		assert.Equal(t, []*Edge{e[2], e[5]}, p.PathBetween(v[1], v[2]))
+		assert.Equal(t, []*Edge{e[2], e[4], e[6]}, p.PathBetween(v[1], v[4]))
+		assert.Empty(t, p.PathBetween(v[1], v[1]))
+	})
+
+	t.Run("should handle unreachable vertices in undirected graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 2)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(e01))
+		assert.NoError(t, g.AddVertices(v2))
+
+		p, err := Johnson(g, 0)
+		assert.NoError(t, err)
+
+		assert.Equal(t, float64(2), p.DistanceBetween(v1, v0))
+		assert.Equal(t, []*Edge{e01}, p.PathBetween(v1, v0))
+		assert.Equal(t, math.MaxFloat64, p.DistanceBetween(v0, v2))
+		assert.Nil(t, p.PathBetween(v0, v2))
+	})
+
+	t.Run("should detect negative cycle", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 1),
+			NewEdge(v1, v2, 1),
+			NewEdge(v2, v1, -3),
+		))
+
+		p, err := Johnson(g, 0)
+		assert.Nil(t, p)
+		assert.ErrorIs(t, err, ErrNegativeCycle)
+	})
+}
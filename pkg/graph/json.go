@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// jsonGraph is a node-link JSON document of GraphOf.
+type jsonGraph[K comparable, V any, W Number] struct {
+	Directed   bool               `json:"directed"`
+	MultiEdges bool               `json:"multiEdges,omitempty"`
+	SelfLoops  bool               `json:"selfLoops,omitempty"`
+	Vertices   []jsonVertex[K, V] `json:"vertices"`
+	Edges      []jsonEdge[K, W]   `json:"edges"`
+}
+
+type jsonVertex[K comparable, V any] struct {
+	Value K              `json:"value"`
+	Data  *V             `json:"data,omitempty"`
+	Attrs map[string]any `json:"attrs,omitempty"`
+}
+
+type jsonEdge[K comparable, W Number] struct {
+	From   K              `json:"from"`
+	To     K              `json:"to"`
+	Weight W              `json:"weight"`
+	Attrs  map[string]any `json:"attrs,omitempty"`
+}
+
+// MarshalJSON encodes Graph as a node-link JSON document: the directed flag
+// and options, vertices with their values, data and attributes, and edges
+// referencing their endpoints by values.
+func (g *GraphOf[K, V, W]) MarshalJSON() ([]byte, error) {
+	doc := jsonGraph[K, V, W]{
+		Directed:   g.directed,
+		MultiEdges: g.multiEdges,
+		SelfLoops:  g.selfLoops,
+		Vertices:   make([]jsonVertex[K, V], len(g.vertices)),
+		Edges:      make([]jsonEdge[K, W], len(g.edges)),
+	}
+	for i, v := range g.vertices {
+		doc.Vertices[i] = jsonVertex[K, V]{Value: v.Value, Attrs: v.attrs}
+		if _, empty := any(v.Data).(struct{}); !empty {
+			doc.Vertices[i].Data = &v.Data
+		}
+	}
+	for i, e := range g.edges {
+		doc.Edges[i] = jsonEdge[K, W]{
+			From:   e.start.Value,
+			To:     e.end.Value,
+			Weight: e.Weight,
+			Attrs:  e.attrs,
+		}
+	}
+	return json.Marshal(doc)
+}
+
+// UnmarshalJSON decodes a node-link JSON document produced by MarshalJSON,
+// replacing the Graph with new vertices and edges. Graph is left intact on
+// failure.
+//
+// Returns ErrNotExists if an edge references a vertex value missing from the
+// vertices list, ErrExists if vertex values are not unique.
+func (g *GraphOf[K, V, W]) UnmarshalJSON(data []byte) error {
+	var doc jsonGraph[K, V, W]
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	var opts []Option
+	if doc.MultiEdges {
+		opts = append(opts, AllowMultiEdges())
+	}
+	if doc.SelfLoops {
+		opts = append(opts, AllowSelfLoops())
+	}
+	decoded := newGraphOf[K, V, W](doc.Directed, opts)
+
+	err := decoded.Batch(func(tx *TxOf[K, V, W]) error {
+		for _, jv := range doc.Vertices {
+			v := &VertexOf[K, V, W]{Value: jv.Value}
+			if jv.Data != nil {
+				v.Data = *jv.Data
+			}
+			v.attrs = jv.Attrs
+			_ = tx.AddVertices(v)
+		}
+		for _, je := range doc.Edges {
+			start, ok := decoded.byValue[je.From]
+			if !ok {
+				return fmt.Errorf("edge %v to %v: vertex %w: %v", je.From, je.To, ErrNotExists, je.From)
+			}
+			end, ok := decoded.byValue[je.To]
+			if !ok {
+				return fmt.Errorf("edge %v to %v: vertex %w: %v", je.From, je.To, ErrNotExists, je.To)
+			}
+			e := NewEdgeOf(start, end, je.Weight)
+			e.attrs = je.Attrs
+			_ = tx.AddEdges(e)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	*g = *decoded
+	return nil
+}
@@ -0,0 +1,170 @@
+package graph
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_MarshalJSON(t *testing.T) {
+	t.Run("should encode node-link document", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		e01 := NewEdge(v0, v1, 1.5)
+		v0.SetAttr("label", "root")
+		e01.SetAttr("color", "red")
+
+		g := NewDirected()
+		assert.NoError(t, g.AddVertices(v2))
+		assert.NoError(t, g.AddEdges(e01, NewEdge(v1, v2, 2)))
+
+		data, err := json.Marshal(g)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"directed": true,
+			"vertices": [
+				{"value": 2},
+				{"value": 0, "attrs": {"label": "root"}},
+				{"value": 1}
+			],
+			"edges": [
+				{"from": 0, "to": 1, "weight": 1.5, "attrs": {"color": "red"}},
+				{"from": 1, "to": 2, "weight": 2}
+			]
+		}`, string(data))
+	})
+
+	t.Run("should encode empty graph", func(t *testing.T) {
+		data, err := json.Marshal(NewUndirected(AllowMultiEdges(), AllowSelfLoops()))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"directed": false,
+			"multiEdges": true,
+			"selfLoops": true,
+			"vertices": [],
+			"edges": []
+		}`, string(data))
+	})
+
+	t.Run("should encode vertex data", func(t *testing.T) {
+		g := NewUndirectedOf[string, int, int]()
+		assert.NoError(t, g.AddEdges(NewEdgeOf(
+			NewVertexOf[string, int, int]("a", 10),
+			NewVertexOf[string, int, int]("b", 20),
+			3,
+		)))
+
+		data, err := json.Marshal(g)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"directed": false,
+			"vertices": [{"value": "a", "data": 10}, {"value": "b", "data": 20}],
+			"edges": [{"from": "a", "to": "b", "weight": 3}]
+		}`, string(data))
+	})
+}
+
+func TestGraph_UnmarshalJSON(t *testing.T) {
+	t.Run("should round-trip graph", func(t *testing.T) {
+		g := NewUndirected(AllowSelfLoops())
+		e01, err := g.AddEdgeByValue(0, 1, 1)
+		assert.NoError(t, err)
+		_, err = g.AddEdgeByValue(1, 1, 2)
+		assert.NoError(t, err)
+		_, err = g.AddEdgeByValue(2, 1, 3)
+		assert.NoError(t, err)
+		e01.SetAttr("color", "red")
+
+		data, err := json.Marshal(g)
+		assert.NoError(t, err)
+
+		var decoded Graph
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+
+		assert.True(t, g.Equal(&decoded))
+		assert.Equal(t, g.String(), decoded.String())
+		assert.Equal(t, g.GetAdjacencyMatrix(), decoded.GetAdjacencyMatrix())
+
+		v1, ok := decoded.Vertex(1)
+		assert.True(t, ok)
+		assert.Len(t, v1.GetEdges(), 3)
+		color, _ := decoded.GetEdges()[0].Attr("color")
+		assert.Equal(t, "red", color)
+
+		// Options are preserved
+		_, err = decoded.AddEdgeByValue(0, 0, 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should round-trip vertex data", func(t *testing.T) {
+		g := NewDirectedOf[string, []string, int]()
+		a := NewVertexOf[string, []string, int]("a", []string{"x", "y"})
+		b := NewVertexOf[string, []string, int]("b", nil)
+		assert.NoError(t, g.AddEdges(NewEdgeOf(a, b, 7)))
+
+		data, err := json.Marshal(g)
+		assert.NoError(t, err)
+
+		decoded := NewUndirectedOf[string, []string, int]()
+		assert.NoError(t, json.Unmarshal(data, decoded))
+
+		assert.True(t, g.Equal(decoded))
+		da, _ := decoded.Vertex("a")
+		assert.Equal(t, []string{"x", "y"}, da.Data)
+		assert.Equal(t, 7, decoded.GetWeight())
+	})
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{
+			name:    "dangling start",
+			data:    `{"directed": true, "vertices": [{"value": 1}], "edges": [{"from": 0, "to": 1}]}`,
+			wantErr: ErrNotExists,
+		},
+		{
+			name:    "dangling end",
+			data:    `{"directed": true, "vertices": [{"value": 0}], "edges": [{"from": 0, "to": 1}]}`,
+			wantErr: ErrNotExists,
+		},
+		{
+			name:    "duplicate vertex",
+			data:    `{"directed": true, "vertices": [{"value": 0}, {"value": 0}], "edges": []}`,
+			wantErr: ErrExists,
+		},
+		{
+			name:    "parallel edge",
+			data:    `{"directed": false, "vertices": [{"value": 0}, {"value": 1}], "edges": [{"from": 0, "to": 1}, {"from": 1, "to": 0}]}`,
+			wantErr: ErrParallelEdge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			g := NewDirected()
+			_, err := g.AddEdgeByValue(5, 6, 1)
+			assert.NoError(t, err)
+
+			err = json.Unmarshal([]byte(tt.data), g)
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			// Graph is left intact
+			assert.Equal(t, "5 6", g.String())
+			assert.Len(t, g.GetEdges(), 1)
+		})
+	}
+
+	t.Run("should reject malformed document", func(t *testing.T) {
+		var g Graph
+		err := json.Unmarshal([]byte(`{"vertices": [{"value": "a"}]}`), &g)
+
+		var typeErr *json.UnmarshalTypeError
+		assert.True(t, errors.As(err, &typeErr))
+	})
+}
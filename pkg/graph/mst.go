@@ -0,0 +1,115 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sewiti/ktu-testing/pkg/unionfind"
+)
+
+// MinimumSpanningTree finds a minimum spanning tree of an undirected Graph
+// using Kruskal's algorithm.
+//
+// See Kruskal for details.
+func MinimumSpanningTree[K comparable, V any, W Number](g *GraphOf[K, V, W]) (*GraphOf[K, V, W], W, error) {
+	return Kruskal(g)
+}
+
+// Kruskal finds a minimum spanning tree of an undirected Graph using Kruskal's
+// algorithm. Disconnected graphs produce a minimum spanning forest.
+//
+// Returns a new undirected Graph with new vertices of the same values and the
+// total weight of its edges.
+//
+// Returns ErrDirected if the Graph is directed.
+func Kruskal[K comparable, V any, W Number](g *GraphOf[K, V, W]) (*GraphOf[K, V, W], W, error) {
+	if g.directed {
+		return nil, 0, fmt.Errorf("minimum spanning tree: %w", ErrDirected)
+	}
+
+	edges := make([]*EdgeOf[K, V, W], len(g.edges))
+	copy(edges, g.edges)
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight < edges[j].Weight
+	})
+
+	indices := g.GetVerticesIndices()
+	sets := unionfind.New(len(g.vertices))
+	var tree []*EdgeOf[K, V, W]
+	for _, e := range edges {
+		if sets.Union(indices[e.start], indices[e.end]) {
+			tree = append(tree, e)
+		}
+	}
+	return spanningTree(g, tree)
+}
+
+// Prim finds a minimum spanning tree of an undirected Graph using Prim's
+// algorithm. Disconnected graphs produce a minimum spanning forest.
+//
+// Returns a new undirected Graph with new vertices of the same values and the
+// total weight of its edges.
+//
+// Returns ErrDirected if the Graph is directed.
+func Prim[K comparable, V any, W Number](g *GraphOf[K, V, W]) (*GraphOf[K, V, W], W, error) {
+	if g.directed {
+		return nil, 0, fmt.Errorf("minimum spanning tree: %w", ErrDirected)
+	}
+
+	inTree := make(map[*VertexOf[K, V, W]]bool, len(g.vertices))
+	via := make(map[*VertexOf[K, V, W]]*EdgeOf[K, V, W]) // Cheapest edge connecting Vertex to the tree
+	var tree []*EdgeOf[K, V, W]
+	for _, root := range g.vertices {
+		if inTree[root] {
+			continue
+		}
+
+		h := &vertexHeap[K, V, W, W]{}
+		h.push(root, 0)
+		for h.Len() > 0 {
+			v := h.pop().vertex
+			if inTree[v] {
+				continue // Stale item
+			}
+			inTree[v] = true
+			if e, ok := via[v]; ok {
+				tree = append(tree, e)
+			}
+
+			for _, e := range v.edges {
+				w := e.Other(v)
+				if inTree[w] {
+					continue
+				}
+				if cur, ok := via[w]; ok && cur.Weight <= e.Weight {
+					continue
+				}
+				via[w] = e
+				h.push(w, e.Weight)
+			}
+		}
+	}
+	return spanningTree(g, tree)
+}
+
+// spanningTree creates a new undirected Graph out of Graph's vertices copies
+// and copies of the tree edges given, data and attributes included.
+func spanningTree[K comparable, V any, W Number](g *GraphOf[K, V, W], tree []*EdgeOf[K, V, W]) (*GraphOf[K, V, W], W, error) {
+	t := NewUndirectedOf[K, V, W]()
+	vertices := make(map[*VertexOf[K, V, W]]*VertexOf[K, V, W], len(g.vertices))
+	for _, v := range g.vertices {
+		vertices[v] = NewVertexOf[K, V, W](v.Value, v.Data)
+		vertices[v].copyAttrs(&v.Attributes)
+		if err := t.AddVertices(vertices[v]); err != nil {
+			return nil, 0, err
+		}
+	}
+	for _, e := range tree {
+		edge := NewEdgeOf(vertices[e.start], vertices[e.end], e.Weight)
+		edge.copyAttrs(&e.Attributes)
+		if err := t.AddEdges(edge); err != nil {
+			return nil, 0, err
+		}
+	}
+	return t, t.GetWeight(), nil
+}
@@ -0,0 +1,160 @@
+package graph
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	type mstFunc func(*Graph) (*Graph, float64, error)
+
+	// edgeStrings retrieves sorted "start to end weight" representations.
+	edgeStrings := func(g *Graph) []string {
+		var s []string
+		for _, e := range g.GetEdges() {
+			if e.start.Value > e.end.Value {
+				e = NewEdge(e.end, e.start, e.Weight)
+			}
+			s = append(s, e.String())
+		}
+		sort.Strings(s)
+		return s
+	}
+
+	algorithms := []struct {
+		name string
+		mst  mstFunc
+	}{
+		{name: "kruskal", mst: Kruskal[int, struct{}, float64]},
+		{name: "prim", mst: Prim[int, struct{}, float64]},
+		{name: "default", mst: MinimumSpanningTree[int, struct{}, float64]},
+	}
+	for _, alg := range algorithms {
+		t.Run(alg.name, func(t *testing.T) {
+			t.Run("should find minimum spanning tree", func(t *testing.T) {
+				v := []*Vertex{
+					NewVertex(0),
+					NewVertex(1),
+					NewVertex(2),
+					NewVertex(3),
+					NewVertex(4),
+				}
+
+				g := NewUndirected()
+				assert.NoError(t, g.AddEdges(
+					NewEdge(v[0], v[1], 2),
+					NewEdge(v[0], v[3], 6),
+					NewEdge(v[1], v[2], 3),
+					NewEdge(v[1], v[3], 8),
+					NewEdge(v[1], v[4], 5),
+					NewEdge(v[2], v[4], 7),
+					NewEdge(v[3], v[4], 9),
+				))
+
+				tree, weight, err := alg.mst(g)
+				assert.NoError(t, err)
+
+				assert.Equal(t, float64(16), weight)
+				assert.Equal(t, tree.GetWeight(), weight)
+				assert.Equal(t, g.String(), tree.String())
+				assert.Equal(t, []string{"0 to 1", "0 to 3", "1 to 2", "1 to 4"}, edgeStrings(tree))
+			})
+
+			t.Run("should not share vertices and edges with original", func(t *testing.T) {
+				v0 := NewVertex(0)
+				v1 := NewVertex(1)
+				e01 := NewEdge(v0, v1, 1)
+
+				g := NewUndirected()
+				assert.NoError(t, g.AddEdges(e01))
+
+				tree, _, err := alg.mst(g)
+				assert.NoError(t, err)
+
+				assert.Len(t, tree.GetEdges(), 1)
+				assert.NotSame(t, e01, tree.GetEdges()[0])
+				assert.NotSame(t, v0, tree.GetVertices()[0])
+				assert.NotSame(t, v1, tree.GetVertices()[1])
+				assert.Equal(t, 1, v0.GetDegree())
+			})
+
+			t.Run("should preserve attributes", func(t *testing.T) {
+				v0 := NewVertex(0)
+				v1 := NewVertex(1)
+				e01 := NewEdge(v0, v1, 1)
+
+				v0.SetAttr("label", "root")
+				e01.SetAttr("color", "red")
+
+				g := NewUndirected()
+				assert.NoError(t, g.AddEdges(e01))
+
+				tree, _, err := alg.mst(g)
+				assert.NoError(t, err)
+
+				assert.Equal(t, v0.Attrs(), tree.GetVertices()[0].Attrs())
+				assert.Equal(t, e01.Attrs(), tree.GetEdges()[0].Attrs())
+
+				tree.GetVertices()[0].SetAttr("label", "changed")
+				label, _ := v0.Attr("label")
+				assert.Equal(t, "root", label)
+			})
+
+			t.Run("should find minimum spanning forest", func(t *testing.T) {
+				v := []*Vertex{
+					NewVertex(0),
+					NewVertex(1),
+					NewVertex(2),
+					NewVertex(3),
+					NewVertex(4),
+				}
+
+				g := NewUndirected()
+				assert.NoError(t, g.AddEdges(
+					NewEdge(v[0], v[1], 1),
+					NewEdge(v[1], v[2], 1),
+					NewEdge(v[0], v[2], 3),
+					NewEdge(v[3], v[4], -2),
+				))
+
+				tree, weight, err := alg.mst(g)
+				assert.NoError(t, err)
+
+				assert.Equal(t, float64(0), weight)
+				assert.Len(t, tree.GetVertices(), 5)
+				assert.Equal(t, []string{"0 to 1", "1 to 2", "3 to 4"}, edgeStrings(tree))
+			})
+
+			t.Run("should throw an error on directed graph", func(t *testing.T) {
+				_, _, err := alg.mst(NewDirected())
+				assert.ErrorIs(t, err, ErrDirected)
+			})
+		})
+	}
+
+	t.Run("should find minimum spanning tree of generic graph", func(t *testing.T) {
+		g := NewUndirectedOf[string, int, int]()
+		for _, e := range []struct {
+			from, to string
+			weight   int
+		}{{"a", "b", 2}, {"b", "c", 3}, {"a", "c", 4}} {
+			_, err := g.AddEdgeByValue(e.from, e.to, e.weight)
+			assert.NoError(t, err)
+		}
+		a, _ := g.Vertex("a")
+		a.Data = 7
+
+		for _, mst := range []func(*GraphOf[string, int, int]) (*GraphOf[string, int, int], int, error){
+			Kruskal[string, int, int], Prim[string, int, int],
+		} {
+			tree, weight, err := mst(g)
+			assert.NoError(t, err)
+			assert.Equal(t, 5, weight)
+			assert.Len(t, tree.GetEdges(), 2)
+			ta, _ := tree.Vertex("a")
+			assert.Equal(t, 7, ta.Data)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+package graph
+
+import (
+	"math"
+	"reflect"
+)
+
+// Paths represents shortest paths from a single source Vertex.
+type Paths = PathsOf[int, struct{}, float64]
+
+// PathsOf represents shortest paths from a single source Vertex: distances to
+// every reachable Vertex and a predecessor tree to reconstruct the routes.
+type PathsOf[K comparable, V any, W Number] struct {
+	source *VertexOf[K, V, W]
+	dist   map[*VertexOf[K, V, W]]W
+	prev   map[*VertexOf[K, V, W]]*EdgeOf[K, V, W]
+}
+
+func newPaths[K comparable, V any, W Number](source *VertexOf[K, V, W]) *PathsOf[K, V, W] {
+	return &PathsOf[K, V, W]{
+		source: source,
+		dist:   map[*VertexOf[K, V, W]]W{source: 0},
+		prev:   make(map[*VertexOf[K, V, W]]*EdgeOf[K, V, W]),
+	}
+}
+
+// Source retrieves the Vertex paths start from.
+func (p *PathsOf[K, V, W]) Source() *VertexOf[K, V, W] {
+	return p.source
+}
+
+// HasPathTo reports whether target is reachable from the source.
+func (p *PathsOf[K, V, W]) HasPathTo(target *VertexOf[K, V, W]) bool {
+	_, ok := p.dist[target]
+	return ok
+}
+
+// DistanceTo retrieves the shortest path weight from the source to target.
+//
+// If target is unreachable, the largest W is returned, math.MaxFloat64 for
+// Paths.
+func (p *PathsOf[K, V, W]) DistanceTo(target *VertexOf[K, V, W]) W {
+	d, ok := p.dist[target]
+	if !ok {
+		return maxWeight[W]()
+	}
+	return d
+}
+
+// PathTo retrieves edges of the shortest path from the source to target in
+// travel order.
+//
+// Returns nil if target is unreachable or is the source itself.
+func (p *PathsOf[K, V, W]) PathTo(target *VertexOf[K, V, W]) []*EdgeOf[K, V, W] {
+	if !p.HasPathTo(target) {
+		return nil
+	}
+
+	var path []*EdgeOf[K, V, W]
+	for v := target; v != p.source; {
+		e := p.prev[v]
+		path = append(path, e)
+		v = e.Other(v)
+	}
+
+	// Reverse to travel order
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// AllPaths represents shortest paths between every pair of vertices.
+type AllPaths = AllPathsOf[int, struct{}, float64]
+
+// AllPathsOf represents shortest paths between every pair of vertices: a
+// distance matrix and a next-hop matrix, both indexed the same way as
+// GetVerticesIndices.
+type AllPathsOf[K comparable, V any, W Number] struct {
+	indices map[*VertexOf[K, V, W]]int
+	dist    [][]W
+	next    [][]int
+	hops    [][]*EdgeOf[K, V, W] // Edge taken from i to its next hop j.
+}
+
+func newAllPaths[K comparable, V any, W Number](g *GraphOf[K, V, W]) *AllPathsOf[K, V, W] {
+	inf := maxWeight[W]()
+	n := len(g.vertices)
+	p := &AllPathsOf[K, V, W]{
+		indices: g.GetVerticesIndices(),
+		dist:    make([][]W, n),
+		next:    make([][]int, n),
+		hops:    make([][]*EdgeOf[K, V, W], n),
+	}
+	for i := 0; i < n; i++ {
+		p.dist[i] = make([]W, n)
+		p.next[i] = make([]int, n)
+		p.hops[i] = make([]*EdgeOf[K, V, W], n)
+		for j := 0; j < n; j++ {
+			p.dist[i][j] = inf
+			p.next[i][j] = -1
+		}
+	}
+	return p
+}
+
+// Distances retrieves the shortest paths weights matrix.
+//
+// If vertices aren't connected, value is set to the largest W,
+// math.MaxFloat64 for AllPaths.
+func (p *AllPathsOf[K, V, W]) Distances() [][]W {
+	return p.dist
+}
+
+// NextHops retrieves the matrix of vertex indices to move to next when
+// travelling along the shortest path.
+//
+// If vertices aren't connected, value is set to -1.
+func (p *AllPathsOf[K, V, W]) NextHops() [][]int {
+	return p.next
+}
+
+// DistanceBetween retrieves the shortest path weight from one Vertex to another.
+//
+// If vertices aren't connected, the largest W is returned, math.MaxFloat64
+// for AllPaths.
+func (p *AllPathsOf[K, V, W]) DistanceBetween(from, to *VertexOf[K, V, W]) W {
+	i, ok := p.indices[from]
+	if !ok {
+		return maxWeight[W]()
+	}
+	j, ok := p.indices[to]
+	if !ok {
+		return maxWeight[W]()
+	}
+	return p.dist[i][j]
+}
+
+// PathBetween retrieves edges of the shortest path from one Vertex to another
+// in travel order.
+//
+// Returns nil if vertices aren't connected or are the same Vertex.
+func (p *AllPathsOf[K, V, W]) PathBetween(from, to *VertexOf[K, V, W]) []*EdgeOf[K, V, W] {
+	i, ok := p.indices[from]
+	if !ok {
+		return nil
+	}
+	j, ok := p.indices[to]
+	if !ok || p.next[i][j] < 0 {
+		return nil
+	}
+
+	var path []*EdgeOf[K, V, W]
+	for i != j {
+		k := p.next[i][j]
+		path = append(path, p.hops[i][k])
+		i = k
+	}
+	return path
+}
+
+// maxWeight retrieves the largest value of W, used for unreachable vertices.
+func maxWeight[W Number]() W {
+	var w W
+	v := reflect.ValueOf(&w).Elem()
+	switch v.Kind() {
+	case reflect.Float32:
+		v.SetFloat(math.MaxFloat32)
+	case reflect.Float64:
+		v.SetFloat(math.MaxFloat64)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(math.MaxInt64 >> (64 - v.Type().Bits()))
+	default:
+		v.SetUint(math.MaxUint64 >> (64 - v.Type().Bits()))
+	}
+	return w
+}
@@ -0,0 +1,136 @@
+package graph
+
+import "fmt"
+
+// StronglyConnectedComponents finds strongly connected components of a
+// directed Graph using Tarjan's algorithm.
+//
+// Components are returned in reverse topological order: no edge goes from a
+// component to any of the ones preceding it.
+//
+// Returns ErrUndirected if the Graph is undirected.
+func StronglyConnectedComponents[K comparable, V any, W Number](g *GraphOf[K, V, W]) ([][]*VertexOf[K, V, W], error) {
+	if !g.directed {
+		return nil, fmt.Errorf("strongly connected components: %w", ErrUndirected)
+	}
+
+	// Iterative to avoid deep recursion on long paths.
+	index := make(map[*VertexOf[K, V, W]]int, len(g.vertices))
+	lowLink := make(map[*VertexOf[K, V, W]]int, len(g.vertices))
+	onStack := make(map[*VertexOf[K, V, W]]bool, len(g.vertices))
+	var stack []*VertexOf[K, V, W]
+	var components [][]*VertexOf[K, V, W]
+
+	for _, root := range g.vertices {
+		if _, ok := index[root]; ok {
+			continue
+		}
+
+		calls := []*sccFrame[K, V, W]{{vertex: root}}
+		index[root] = len(index)
+		lowLink[root] = index[root]
+		stack = append(stack, root)
+		onStack[root] = true
+
+		for len(calls) > 0 {
+			top := calls[len(calls)-1]
+			v := top.vertex
+
+			if top.next < len(v.edges) {
+				w := v.edges[top.next].end
+				top.next++
+
+				if _, ok := index[w]; !ok {
+					index[w] = len(index)
+					lowLink[w] = index[w]
+					stack = append(stack, w)
+					onStack[w] = true
+					calls = append(calls, &sccFrame[K, V, W]{vertex: w})
+				} else if onStack[w] && index[w] < lowLink[v] {
+					lowLink[v] = index[w]
+				}
+				continue
+			}
+
+			// All edges explored, return to the caller
+			calls = calls[:len(calls)-1]
+			if len(calls) > 0 {
+				parent := calls[len(calls)-1].vertex
+				if lowLink[v] < lowLink[parent] {
+					lowLink[parent] = lowLink[v]
+				}
+			}
+
+			if lowLink[v] == index[v] {
+				var component []*VertexOf[K, V, W]
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[w] = false
+					component = append(component, w)
+					if w == v {
+						break
+					}
+				}
+				components = append(components, component)
+			}
+		}
+	}
+	return components, nil
+}
+
+// sccFrame is a Vertex on the Tarjan's algorithm call stack.
+type sccFrame[K comparable, V any, W Number] struct {
+	vertex *VertexOf[K, V, W]
+	next   int // Next edge to explore
+}
+
+// Condensation creates a new directed Graph where each strongly connected
+// component of a directed Graph is collapsed to a single Vertex. The result is
+// always acyclic.
+//
+// Condensed vertices values are component indices as returned by
+// StronglyConnectedComponents. Components are connected by a single edge
+// weighing the same as the lightest edge between them.
+//
+// Returns the condensed Graph and a map from condensed vertices to the
+// original vertices they contain.
+//
+// Returns ErrUndirected if the Graph is undirected.
+func Condensation[K comparable, V any, W Number](g *GraphOf[K, V, W]) (*GraphOf[int, struct{}, W], map[*VertexOf[int, struct{}, W]][]*VertexOf[K, V, W], error) {
+	components, err := StronglyConnectedComponents(g)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := NewDirectedOf[int, struct{}, W]()
+	originals := make(map[*VertexOf[int, struct{}, W]][]*VertexOf[K, V, W], len(components))
+	condensed := make(map[*VertexOf[K, V, W]]*VertexOf[int, struct{}, W], len(g.vertices))
+	for i, component := range components {
+		v := NewVertexOf[int, struct{}, W](i, struct{}{})
+		if err := c.AddVertices(v); err != nil {
+			return nil, nil, err
+		}
+		originals[v] = component
+		for _, w := range component {
+			condensed[w] = v
+		}
+	}
+
+	for _, e := range g.edges {
+		start, end := condensed[e.start], condensed[e.end]
+		if start == end {
+			continue
+		}
+		if existing := c.FindEdge(start, end); existing != nil {
+			if e.Weight < existing.Weight {
+				existing.Weight = e.Weight
+			}
+			continue
+		}
+		if err := c.AddEdges(NewEdgeOf(start, end, e.Weight)); err != nil {
+			return nil, nil, err
+		}
+	}
+	return c, originals, nil
+}
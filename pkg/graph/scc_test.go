@@ -0,0 +1,158 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	t.Run("should find strongly connected components", func(t *testing.T) {
+		v := make([]*Vertex, 8)
+		for i := range v {
+			v[i] = NewVertex(i)
+		}
+
+		g := NewDirected()
+		assert.NoError(t, g.AddVertices(v...))
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v[0], v[1], 0),
+			NewEdge(v[1], v[2], 0),
+			NewEdge(v[2], v[0], 0),
+			NewEdge(v[2], v[3], 0),
+			NewEdge(v[3], v[4], 0),
+			NewEdge(v[4], v[5], 0),
+			NewEdge(v[5], v[3], 0),
+			NewEdge(v[6], v[5], 0),
+			NewEdge(v[6], v[7], 0),
+			NewEdge(v[7], v[6], 0),
+		))
+
+		components, err := StronglyConnectedComponents(g)
+		assert.NoError(t, err)
+
+		assert.Len(t, components, 3)
+		assert.ElementsMatch(t, []*Vertex{v[3], v[4], v[5]}, components[0])
+		assert.ElementsMatch(t, []*Vertex{v[0], v[1], v[2]}, components[1])
+		assert.ElementsMatch(t, []*Vertex{v[6], v[7]}, components[2])
+	})
+
+	t.Run("should put every vertex of acyclic graph into its own component", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v1, v2, 0),
+		))
+
+		components, err := StronglyConnectedComponents(g)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]*Vertex{{v2}, {v1}, {v0}}, components)
+	})
+
+	t.Run("should handle long paths without recursion", func(t *testing.T) {
+		const n = 10000
+
+		v := make([]*Vertex, n)
+		e := make([]*Edge, n)
+		for i := range v {
+			v[i] = NewVertex(i)
+		}
+		for i := range e {
+			e[i] = NewEdge(v[i], v[(i+1)%n], 0)
+		}
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e...))
+
+		components, err := StronglyConnectedComponents(g)
+		assert.NoError(t, err)
+		assert.Len(t, components, 1)
+		assert.Len(t, components[0], n)
+	})
+
+	t.Run("should throw an error on undirected graph", func(t *testing.T) {
+		_, err := StronglyConnectedComponents(NewUndirected())
+		assert.ErrorIs(t, err, ErrUndirected)
+	})
+
+	t.Run("should find components of service graph", func(t *testing.T) {
+		g := NewDirectedOf[string, struct{}, int]()
+		for _, e := range [][2]string{{"api", "auth"}, {"auth", "api"}, {"api", "db"}} {
+			_, err := g.AddEdgeByValue(e[0], e[1], 1)
+			assert.NoError(t, err)
+		}
+
+		components, err := StronglyConnectedComponents(g)
+		assert.NoError(t, err)
+		assert.Len(t, components, 2)
+		assert.Equal(t, "db", components[0][0].Value)
+		assert.Len(t, components[1], 2)
+	})
+}
+
+func TestCondensation(t *testing.T) {
+	t.Run("should collapse components into vertices", func(t *testing.T) {
+		v := make([]*Vertex, 5)
+		for i := range v {
+			v[i] = NewVertex(i)
+		}
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v[0], v[1], 1),
+			NewEdge(v[1], v[0], 1),
+			NewEdge(v[1], v[2], 5),
+			NewEdge(v[0], v[2], 3),
+			NewEdge(v[2], v[3], 1),
+			NewEdge(v[3], v[2], 1),
+			NewEdge(v[3], v[4], 2),
+		))
+
+		c, originals, err := Condensation(g)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "0 1 2", c.String())
+		assert.Len(t, c.GetEdges(), 2)
+
+		cv := c.GetVertices()
+		assert.Equal(t, []*Vertex{v[4]}, originals[cv[0]])
+		assert.ElementsMatch(t, []*Vertex{v[2], v[3]}, originals[cv[1]])
+		assert.ElementsMatch(t, []*Vertex{v[0], v[1]}, originals[cv[2]])
+
+		assert.Equal(t, float64(3), c.FindEdge(cv[2], cv[1]).Weight)
+		assert.Equal(t, float64(2), c.FindEdge(cv[1], cv[0]).Weight)
+
+		_, err = TopologicalSort(c)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should throw an error on undirected graph", func(t *testing.T) {
+		_, _, err := Condensation(NewUndirected())
+		assert.ErrorIs(t, err, ErrUndirected)
+	})
+
+	t.Run("should condense service graph keeping integer weights", func(t *testing.T) {
+		g := NewDirectedOf[string, struct{}, int]()
+		for _, e := range []struct {
+			from, to string
+			weight   int
+		}{{"api", "auth", 1}, {"auth", "api", 1}, {"api", "db", 5}, {"auth", "db", 3}} {
+			_, err := g.AddEdgeByValue(e.from, e.to, e.weight)
+			assert.NoError(t, err)
+		}
+
+		c, originals, err := Condensation(g)
+		assert.NoError(t, err)
+		assert.Equal(t, "0 1", c.String())
+		assert.Len(t, c.GetEdges(), 1)
+		assert.Equal(t, 3, c.GetWeight())
+
+		db, _ := g.Vertex("db")
+		v0, _ := c.Vertex(0)
+		assert.Equal(t, []*VertexOf[string, struct{}, int]{db}, originals[v0])
+	})
+}
@@ -0,0 +1,144 @@
+package graph
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// CycleError reports a cycle found in the Graph.
+type CycleError = CycleErrorOf[int, struct{}, float64]
+
+// CycleErrorOf reports a cycle found in the GraphOf.
+//
+// It matches ErrCycle with errors.Is.
+type CycleErrorOf[K comparable, V any, W Number] struct {
+	Cycle []*EdgeOf[K, V, W] // Cycle edges in travel order.
+}
+
+// Error retrieves a string representation of the cycle.
+func (e *CycleErrorOf[K, V, W]) Error() string {
+	return ErrCycle.Error() + ": " + edgesString(e.Cycle)
+}
+
+// Unwrap retrieves ErrCycle.
+func (e *CycleErrorOf[K, V, W]) Unwrap() error {
+	return ErrCycle
+}
+
+// TopologicalSort orders vertices of a directed Graph so that every edge goes
+// from an earlier Vertex to a later one, using Kahn's algorithm. Among the
+// vertices available at each step the one with the lowest Value goes first.
+// Values of types other than integers, floats and strings are not ordered,
+// then the Vertex added to the Graph first goes first.
+//
+// Returns ErrUndirected if the Graph is undirected and *CycleErrorOf if it
+// contains a cycle.
+func TopologicalSort[K comparable, V any, W Number](g *GraphOf[K, V, W]) ([]*VertexOf[K, V, W], error) {
+	if !g.directed {
+		return nil, fmt.Errorf("topological sort: %w", ErrUndirected)
+	}
+
+	rank := valueRanks(g.vertices)
+	inDegree := make(map[*VertexOf[K, V, W]]int, len(g.vertices))
+	ready := &vertexHeap[K, V, W, int]{}
+	for _, v := range g.vertices {
+		inDegree[v] = v.InDegree()
+		if inDegree[v] == 0 {
+			ready.push(v, rank[v])
+		}
+	}
+
+	order := make([]*VertexOf[K, V, W], 0, len(g.vertices))
+	for ready.Len() > 0 {
+		v := ready.pop().vertex
+		order = append(order, v)
+		for _, e := range v.edges {
+			inDegree[e.end]--
+			if inDegree[e.end] == 0 {
+				ready.push(e.end, rank[e.end])
+			}
+		}
+	}
+
+	if len(order) < len(g.vertices) {
+		return nil, &CycleErrorOf[K, V, W]{Cycle: findCycle(g, inDegree)}
+	}
+	return order, nil
+}
+
+// findCycle finds a cycle among the vertices Kahn's algorithm couldn't remove,
+// i.e. the ones with positive remaining in-degree.
+func findCycle[K comparable, V any, W Number](g *GraphOf[K, V, W], inDegree map[*VertexOf[K, V, W]]int) []*EdgeOf[K, V, W] {
+	// Every remaining Vertex has an incoming edge from another remaining one,
+	// so walking them backwards eventually loops.
+	incoming := make(map[*VertexOf[K, V, W]]*EdgeOf[K, V, W])
+	for _, e := range g.edges {
+		if inDegree[e.start] > 0 && inDegree[e.end] > 0 {
+			if _, ok := incoming[e.end]; !ok {
+				incoming[e.end] = e
+			}
+		}
+	}
+
+	var v *VertexOf[K, V, W]
+	for _, w := range g.vertices {
+		if inDegree[w] > 0 {
+			v = w
+			break
+		}
+	}
+
+	step := make(map[*VertexOf[K, V, W]]int)
+	var walk []*EdgeOf[K, V, W]
+	for {
+		if i, ok := step[v]; ok {
+			walk = walk[i:]
+			break
+		}
+		step[v] = len(walk)
+		e := incoming[v]
+		walk = append(walk, e)
+		v = e.start
+	}
+
+	// Reverse to travel order
+	for i, j := 0, len(walk)-1; i < j; i, j = i+1, j-1 {
+		walk[i], walk[j] = walk[j], walk[i]
+	}
+	return walk
+}
+
+// valueRanks ranks vertices by their values, keeping their order for values
+// which are equal or not ordered.
+func valueRanks[K comparable, V any, W Number](vertices []*VertexOf[K, V, W]) map[*VertexOf[K, V, W]]int {
+	sorted := append([]*VertexOf[K, V, W](nil), vertices...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return lessValue(reflect.ValueOf(sorted[i].Value), reflect.ValueOf(sorted[j].Value))
+	})
+
+	rank := make(map[*VertexOf[K, V, W]]int, len(sorted))
+	for i, v := range sorted {
+		rank[v] = i
+	}
+	return rank
+}
+
+// lessValue reports whether a is less than b, false if their kind is not
+// ordered.
+func lessValue(a, b reflect.Value) bool {
+	if a.Kind() != b.Kind() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	t.Run("should sort directed acyclic graph", func(t *testing.T) {
+		v := []*Vertex{
+			NewVertex(5),
+			NewVertex(7),
+			NewVertex(3),
+			NewVertex(11),
+			NewVertex(8),
+			NewVertex(2),
+			NewVertex(9),
+			NewVertex(10),
+		}
+
+		g := NewDirected()
+		assert.NoError(t, g.AddVertices(v...))
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v[0], v[3], 0), // 5 to 11
+			NewEdge(v[1], v[3], 0), // 7 to 11
+			NewEdge(v[1], v[4], 0), // 7 to 8
+			NewEdge(v[2], v[4], 0), // 3 to 8
+			NewEdge(v[2], v[7], 0), // 3 to 10
+			NewEdge(v[3], v[5], 0), // 11 to 2
+			NewEdge(v[3], v[6], 0), // 11 to 9
+			NewEdge(v[3], v[7], 0), // 11 to 10
+			NewEdge(v[4], v[6], 0), // 8 to 9
+		))
+
+		order, err := TopologicalSort(g)
+		assert.NoError(t, err)
+
+		values := make([]int, len(order))
+		for i, v := range order {
+			values[i] = v.Value
+		}
+		assert.Equal(t, []int{3, 5, 7, 8, 11, 2, 9, 10}, values)
+	})
+
+	t.Run("should sort graph without edges by value", func(t *testing.T) {
+		v2 := NewVertex(2)
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddVertices(v2, v0, v1))
+
+		order, err := TopologicalSort(g)
+		assert.NoError(t, err)
+		assert.Equal(t, []*Vertex{v0, v1, v2}, order)
+	})
+
+	t.Run("should report a cycle", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+
+		e01 := NewEdge(v0, v1, 0)
+		e12 := NewEdge(v1, v2, 0)
+		e23 := NewEdge(v2, v3, 0)
+		e31 := NewEdge(v3, v1, 0)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(e01, e12, e23, e31))
+
+		order, err := TopologicalSort(g)
+		assert.Nil(t, order)
+		assert.ErrorIs(t, err, ErrCycle)
+
+		var cycleErr *CycleError
+		assert.True(t, errors.As(err, &cycleErr))
+		assert.ElementsMatch(t, []*Edge{e12, e23, e31}, cycleErr.Cycle)
+		for i, e := range cycleErr.Cycle {
+			next := cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]
+			assert.Equal(t, e.end, next.start)
+		}
+	})
+
+	t.Run("should report a self-loop as a cycle", func(t *testing.T) {
+		v0 := NewVertex(0)
+		e00 := NewEdge(v0, v0, 0)
+
+		g := NewDirected(AllowSelfLoops())
+		assert.NoError(t, g.AddEdges(e00))
+
+		_, err := TopologicalSort(g)
+		assert.EqualError(t, err, "cycle: 0 to 0")
+	})
+
+	t.Run("should throw an error on undirected graph", func(t *testing.T) {
+		_, err := TopologicalSort(NewUndirected())
+		assert.ErrorIs(t, err, ErrUndirected)
+	})
+
+	t.Run("should sort service graph by string values", func(t *testing.T) {
+		g := NewDirectedOf[string, struct{}, int]()
+		for _, e := range [][2]string{{"web", "api"}, {"cron", "db"}, {"api", "db"}} {
+			_, err := g.AddEdgeByValue(e[0], e[1], 0)
+			assert.NoError(t, err)
+		}
+
+		order, err := TopologicalSort(g)
+		assert.NoError(t, err)
+
+		values := make([]string, len(order))
+		for i, v := range order {
+			values[i] = v.Value
+		}
+		assert.Equal(t, []string{"cron", "web", "api", "db"}, values)
+	})
+
+	t.Run("should keep graph order for unordered values", func(t *testing.T) {
+		type id struct{ n int }
+
+		g := NewDirectedOf[id, struct{}, int]()
+		assert.NoError(t, g.AddVertices(
+			NewVertexOf[id, struct{}, int](id{2}, struct{}{}),
+			NewVertexOf[id, struct{}, int](id{1}, struct{}{}),
+		))
+
+		order, err := TopologicalSort(g)
+		assert.NoError(t, err)
+		assert.Equal(t, id{2}, order[0].Value)
+	})
+
+	t.Run("should report a cycle in generic graph", func(t *testing.T) {
+		g := NewDirectedOf[string, struct{}, int]()
+		_, err := g.AddEdgeByValue("a", "b", 0)
+		assert.NoError(t, err)
+		_, err = g.AddEdgeByValue("b", "a", 0)
+		assert.NoError(t, err)
+
+		_, err = TopologicalSort(g)
+		var cycleErr *CycleErrorOf[string, struct{}, int]
+		assert.True(t, errors.As(err, &cycleErr))
+		assert.EqualError(t, err, "cycle: a to b, b to a")
+	})
+}
@@ -0,0 +1,123 @@
+package graph
+
+// BreadthFirst traverses Graph in breadth-first order starting at the Vertex
+// given. Directed graphs are traversed only along outgoing edges.
+//
+// visit is called once for each reachable Vertex with its depth (number of
+// edges from the start). Returning false from visit stops the traversal.
+//
+// Returns ErrNotExists if start Vertex is not in the Graph.
+func (g *GraphOf[K, V, W]) BreadthFirst(start *VertexOf[K, V, W], visit func(v *VertexOf[K, V, W], depth int) bool) error {
+	return g.BreadthFirstFrom([]*VertexOf[K, V, W]{start}, visit)
+}
+
+// BreadthFirstFrom traverses Graph in breadth-first order seeding the queue
+// with all of the start vertices, each of them at depth 0.
+//
+// Returns ErrNotExists if any of the start vertices is not in the Graph.
+func (g *GraphOf[K, V, W]) BreadthFirstFrom(starts []*VertexOf[K, V, W], visit func(v *VertexOf[K, V, W], depth int) bool) error {
+	if err := g.checkVertices(starts); err != nil {
+		return err
+	}
+
+	visited := make(map[*VertexOf[K, V, W]]bool, len(g.vertices))
+	queue := make([]bfsItem[K, V, W], 0, len(starts))
+	for _, v := range starts {
+		if visited[v] {
+			continue
+		}
+		visited[v] = true
+		queue = append(queue, bfsItem[K, V, W]{vertex: v})
+	}
+
+	for len(queue) > 0 {
+		it := queue[0]
+		queue = queue[1:]
+
+		if !visit(it.vertex, it.depth) {
+			return nil
+		}
+		for _, neighbor := range it.vertex.GetNeighbors() {
+			if visited[neighbor] {
+				continue
+			}
+			visited[neighbor] = true
+			queue = append(queue, bfsItem[K, V, W]{vertex: neighbor, depth: it.depth + 1})
+		}
+	}
+	return nil
+}
+
+// DepthFirst traverses Graph in depth-first order starting at the Vertex given.
+// Directed graphs are traversed only along outgoing edges.
+//
+// enter is called when a Vertex is discovered, leave is called once all of its
+// descendants have been explored. Both receive the Vertex depth in the
+// traversal tree and either may be nil. Returning false from enter stops the
+// traversal, leave is not called for the vertices which are still being
+// explored.
+//
+// Returns ErrNotExists if start Vertex is not in the Graph.
+func (g *GraphOf[K, V, W]) DepthFirst(start *VertexOf[K, V, W], enter func(v *VertexOf[K, V, W], depth int) bool, leave func(v *VertexOf[K, V, W], depth int)) error {
+	return g.DepthFirstFrom([]*VertexOf[K, V, W]{start}, enter, leave)
+}
+
+// DepthFirstFrom traverses Graph in depth-first order from each of the start
+// vertices in turn, skipping the ones already visited by a previous start.
+//
+// Returns ErrNotExists if any of the start vertices is not in the Graph.
+func (g *GraphOf[K, V, W]) DepthFirstFrom(starts []*VertexOf[K, V, W], enter func(v *VertexOf[K, V, W], depth int) bool, leave func(v *VertexOf[K, V, W], depth int)) error {
+	if err := g.checkVertices(starts); err != nil {
+		return err
+	}
+
+	// Iterative to avoid deep recursion on long paths.
+	visited := make(map[*VertexOf[K, V, W]]bool, len(g.vertices))
+	for _, start := range starts {
+		if visited[start] {
+			continue
+		}
+		visited[start] = true
+		if enter != nil && !enter(start, 0) {
+			return nil
+		}
+
+		stack := []*dfsFrame[K, V, W]{{vertex: start, neighbors: start.GetNeighbors()}}
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if top.next == len(top.neighbors) {
+				stack = stack[:len(stack)-1]
+				if leave != nil {
+					leave(top.vertex, len(stack))
+				}
+				continue
+			}
+
+			neighbor := top.neighbors[top.next]
+			top.next++
+			if visited[neighbor] {
+				continue
+			}
+			visited[neighbor] = true
+			if enter != nil && !enter(neighbor, len(stack)) {
+				return nil
+			}
+			stack = append(stack, &dfsFrame[K, V, W]{vertex: neighbor, neighbors: neighbor.GetNeighbors()})
+		}
+	}
+	return nil
+}
+
+// bfsItem is a queued Vertex of the breadth-first traversal.
+type bfsItem[K comparable, V any, W Number] struct {
+	vertex *VertexOf[K, V, W]
+	depth  int
+}
+
+// dfsFrame is a Vertex on the depth-first traversal stack with its neighbors
+// left to explore.
+type dfsFrame[K comparable, V any, W Number] struct {
+	vertex    *VertexOf[K, V, W]
+	neighbors []*VertexOf[K, V, W]
+	next      int
+}
@@ -0,0 +1,233 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_BreadthFirst(t *testing.T) {
+	t.Run("should traverse undirected graph in breadth-first order", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+		v4 := NewVertex(4)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v0, v2, 0),
+			NewEdge(v1, v3, 0),
+			NewEdge(v2, v4, 0),
+		))
+
+		var order []*Vertex
+		var depths []int
+		assert.NoError(t, g.BreadthFirst(v1, func(v *Vertex, depth int) bool {
+			order = append(order, v)
+			depths = append(depths, depth)
+			return true
+		}))
+
+		assert.Equal(t, []*Vertex{v1, v0, v3, v2, v4}, order)
+		assert.Equal(t, []int{0, 1, 1, 2, 3}, depths)
+	})
+
+	t.Run("should follow only outgoing edges in directed graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v2, v1, 0),
+		))
+
+		var order []*Vertex
+		assert.NoError(t, g.BreadthFirst(v1, func(v *Vertex, depth int) bool {
+			order = append(order, v)
+			return true
+		}))
+		assert.Equal(t, []*Vertex{v1}, order)
+
+		order = nil
+		assert.NoError(t, g.BreadthFirst(v0, func(v *Vertex, depth int) bool {
+			order = append(order, v)
+			return true
+		}))
+		assert.Equal(t, []*Vertex{v0, v1}, order)
+	})
+
+	t.Run("should stop traversal when visit returns false", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v1, v2, 0),
+		))
+
+		var order []*Vertex
+		assert.NoError(t, g.BreadthFirst(v0, func(v *Vertex, depth int) bool {
+			order = append(order, v)
+			return v != v1
+		}))
+		assert.Equal(t, []*Vertex{v0, v1}, order)
+	})
+
+	t.Run("should seed traversal with multiple sources", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v1, v2, 0),
+			NewEdge(v3, v2, 0),
+		))
+
+		depths := make(map[*Vertex]int)
+		assert.NoError(t, g.BreadthFirstFrom([]*Vertex{v0, v3}, func(v *Vertex, depth int) bool {
+			depths[v] = depth
+			return true
+		}))
+		assert.Equal(t, map[*Vertex]int{v0: 0, v3: 0, v1: 1, v2: 1}, depths)
+	})
+
+	t.Run("should throw an error when start vertex is not in graph", func(t *testing.T) {
+		g := NewUndirected()
+		assert.NoError(t, g.AddVertices(NewVertex(0)))
+
+		err := g.BreadthFirst(NewVertex(1), func(v *Vertex, depth int) bool {
+			return true
+		})
+		assert.ErrorIs(t, err, ErrNotExists)
+	})
+}
+
+func TestGraph_DepthFirst(t *testing.T) {
+	t.Run("should traverse graph in depth-first order", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+
+		g := NewUndirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v1, v2, 0),
+			NewEdge(v0, v3, 0),
+		))
+
+		var events []string
+		assert.NoError(t, g.DepthFirst(v0,
+			func(v *Vertex, depth int) bool {
+				events = append(events, "enter "+v.String())
+				return true
+			},
+			func(v *Vertex, depth int) {
+				events = append(events, "leave "+v.String())
+			},
+		))
+
+		expected := []string{
+			"enter 0",
+			"enter 1",
+			"enter 2",
+			"leave 2",
+			"leave 1",
+			"enter 3",
+			"leave 3",
+			"leave 0",
+		}
+		assert.Equal(t, expected, events)
+	})
+
+	t.Run("should report depth on enter and leave", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v1, v2, 0),
+		))
+
+		enterDepths := make(map[*Vertex]int)
+		leaveDepths := make(map[*Vertex]int)
+		assert.NoError(t, g.DepthFirst(v0,
+			func(v *Vertex, depth int) bool {
+				enterDepths[v] = depth
+				return true
+			},
+			func(v *Vertex, depth int) {
+				leaveDepths[v] = depth
+			},
+		))
+
+		expected := map[*Vertex]int{v0: 0, v1: 1, v2: 2}
+		assert.Equal(t, expected, enterDepths)
+		assert.Equal(t, expected, leaveDepths)
+	})
+
+	t.Run("should stop traversal when enter returns false", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v1, v2, 0),
+		))
+
+		var entered, left []*Vertex
+		assert.NoError(t, g.DepthFirst(v0,
+			func(v *Vertex, depth int) bool {
+				entered = append(entered, v)
+				return v != v1
+			},
+			func(v *Vertex, depth int) {
+				left = append(left, v)
+			},
+		))
+		assert.Equal(t, []*Vertex{v0, v1}, entered)
+		assert.Empty(t, left)
+	})
+
+	t.Run("should visit every source once in multi-source traversal", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+		v2 := NewVertex(2)
+		v3 := NewVertex(3)
+
+		g := NewDirected()
+		assert.NoError(t, g.AddEdges(
+			NewEdge(v0, v1, 0),
+			NewEdge(v2, v1, 0),
+		))
+		assert.NoError(t, g.AddVertices(v3))
+
+		var order []*Vertex
+		assert.NoError(t, g.DepthFirstFrom([]*Vertex{v0, v2, v0, v3},
+			func(v *Vertex, depth int) bool {
+				order = append(order, v)
+				return true
+			},
+			nil,
+		))
+		assert.Equal(t, []*Vertex{v0, v1, v2, v3}, order)
+	})
+
+	t.Run("should throw an error when start vertex is not in graph", func(t *testing.T) {
+		g := NewDirected()
+		assert.ErrorIs(t, g.DepthFirst(NewVertex(0), nil, nil), ErrNotExists)
+	})
+}
@@ -1,25 +1,45 @@
 package graph
 
-import (
-	"fmt"
-	"strconv"
-)
+import "fmt"
 
 // Vertex represents a single point of the Graph.
-type Vertex struct {
-	Value int // Unique Vertex value.
-	edges []*Edge
+type Vertex = VertexOf[int, struct{}, float64]
+
+// VertexOf represents a single point of the GraphOf, identified by a unique
+// value of type K and carrying arbitrary data of type V.
+type VertexOf[K comparable, V any, W Number] struct {
+	Attributes
+
+	Value K // Unique Vertex value.
+	Data  V // Arbitrary Vertex payload.
+	edges []*EdgeOf[K, V, W]
+	in    []*EdgeOf[K, V, W] // Incoming edges, maintained by Graph.
+
+	// Index of edges for constant time lookups, built once there are more
+	// than indexDegree edges: the edges themselves and the number of edges
+	// connecting each neighbor.
+	edgeSet   map[*EdgeOf[K, V, W]]struct{}
+	neighbors map[*VertexOf[K, V, W]]int
 }
 
+// indexDegree is the number of Vertex edges up to which they are scanned
+// instead of indexed, as maintaining the index costs more for fewer edges.
+const indexDegree = 16
+
 // NewVertex creates a new Vertex with a value.
 func NewVertex(value int) *Vertex {
 	return &Vertex{Value: value}
 }
 
+// NewVertexOf creates a new VertexOf with a value and data.
+func NewVertexOf[K comparable, V any, W Number](value K, data V) *VertexOf[K, V, W] {
+	return &VertexOf[K, V, W]{Value: value, Data: data}
+}
+
 // AddEdges adds edges to Vertex.
 //
 // Returns first ErrExists if Edge is a duplicate.
-func (v *Vertex) AddEdges(e ...*Edge) error {
+func (v *VertexOf[K, V, W]) AddEdges(e ...*EdgeOf[K, V, W]) error {
 	for _, e := range e {
 		if err := v.addEdge(e); err != nil {
 			return err
@@ -28,23 +48,101 @@ func (v *Vertex) AddEdges(e ...*Edge) error {
 	return nil
 }
 
-func (v *Vertex) addEdge(e *Edge) error {
-	for _, edge := range v.edges {
-		if edge == e {
-			return fmt.Errorf("edge %w: %s", ErrExists, e)
-		}
+func (v *VertexOf[K, V, W]) addEdge(e *EdgeOf[K, V, W]) error {
+	if v.HasEdge(e) {
+		return fmt.Errorf("edge %w: %s", ErrExists, e)
 	}
-	v.edges = append(v.edges, e)
+	v.appendEdge(e)
 	return nil
 }
 
+// appendEdge appends an edge to Vertex edges, adding it to the index.
+func (v *VertexOf[K, V, W]) appendEdge(e *EdgeOf[K, V, W]) {
+	v.edges = append(v.edges, e)
+	switch {
+	case v.edgeSet != nil:
+		v.indexEdge(e)
+	case len(v.edges) > indexDegree:
+		v.edgeSet = make(map[*EdgeOf[K, V, W]]struct{}, len(v.edges))
+		v.neighbors = make(map[*VertexOf[K, V, W]]int, len(v.edges))
+		for _, e := range v.edges {
+			v.indexEdge(e)
+		}
+	}
+}
+
+// truncateEdges deletes Vertex edges past the first n, deleting them from the
+// index.
+func (v *VertexOf[K, V, W]) truncateEdges(n int) {
+	for _, e := range v.edges[n:] {
+		v.unindexEdge(e)
+	}
+	v.edges = v.edges[:n]
+}
+
+// setEdges replaces Vertex edges, rebuilding the index.
+func (v *VertexOf[K, V, W]) setEdges(edges []*EdgeOf[K, V, W]) {
+	v.DeleteAllEdges()
+	for _, e := range edges {
+		v.appendEdge(e)
+	}
+}
+
+func (v *VertexOf[K, V, W]) indexEdge(e *EdgeOf[K, V, W]) {
+	v.edgeSet[e] = struct{}{}
+	v.neighbors[v.neighbor(e)]++
+}
+
+func (v *VertexOf[K, V, W]) unindexEdge(e *EdgeOf[K, V, W]) {
+	if v.edgeSet == nil {
+		return
+	}
+	delete(v.edgeSet, e)
+	w := v.neighbor(e)
+	if v.neighbors[w]--; v.neighbors[w] == 0 {
+		delete(v.neighbors, w)
+	}
+}
+
+// neighbor retrieves the end of the Vertex edge other than the Vertex itself.
+func (v *VertexOf[K, V, W]) neighbor(e *EdgeOf[K, V, W]) *VertexOf[K, V, W] {
+	if e.start == v {
+		return e.end
+	}
+	return e.start
+}
+
 // DeleteEdge deletes an edge from a Vertex.
 //
 // Returns ErrNotExists if edge doesn't exist.
-func (v *Vertex) DeleteEdge(e *Edge) error {
+func (v *VertexOf[K, V, W]) DeleteEdge(e *EdgeOf[K, V, W]) error {
+	if !v.HasEdge(e) {
+		return fmt.Errorf("edge %w: %s", ErrNotExists, e)
+	}
 	for i, w := range v.edges {
 		if w == e {
 			v.edges = append(v.edges[:i], v.edges[i+1:]...)
+			break
+		}
+	}
+	v.unindexEdge(e)
+	return nil
+}
+
+func (v *VertexOf[K, V, W]) addInEdge(e *EdgeOf[K, V, W]) error {
+	for _, edge := range v.in {
+		if edge == e {
+			return fmt.Errorf("edge %w: %s", ErrExists, e)
+		}
+	}
+	v.in = append(v.in, e)
+	return nil
+}
+
+func (v *VertexOf[K, V, W]) deleteInEdge(e *EdgeOf[K, V, W]) error {
+	for i, w := range v.in {
+		if w == e {
+			v.in = append(v.in[:i], v.in[i+1:]...)
 			return nil
 		}
 	}
@@ -52,30 +150,57 @@ func (v *Vertex) DeleteEdge(e *Edge) error {
 }
 
 // GetNeighbors retrieves Vertex neighbors with whom the Vertex is connected.
-func (v *Vertex) GetNeighbors() []*Vertex {
-	var vertices []*Vertex
+func (v *VertexOf[K, V, W]) GetNeighbors() []*VertexOf[K, V, W] {
+	var vertices []*VertexOf[K, V, W]
 	for _, e := range v.edges {
-		neighbor := e.start
-		if neighbor == v {
-			neighbor = e.end
-		}
-		vertices = append(vertices, neighbor)
+		vertices = append(vertices, v.neighbor(e))
 	}
 	return vertices
 }
 
 // GetEdges retrieves all Vertex edges.
-func (v *Vertex) GetEdges() []*Edge {
+func (v *VertexOf[K, V, W]) GetEdges() []*EdgeOf[K, V, W] {
 	return v.edges
 }
 
 // GetDegree retrieves the number of outgoing edges Vertex has.
-func (v *Vertex) GetDegree() int {
+func (v *VertexOf[K, V, W]) GetDegree() int {
+	return len(v.edges)
+}
+
+// InEdges retrieves edges coming into Vertex, as added by Graph. In undirected
+// graphs these are the same as GetEdges.
+func (v *VertexOf[K, V, W]) InEdges() []*EdgeOf[K, V, W] {
+	return v.in
+}
+
+// InNeighbors retrieves vertices which have edges coming into Vertex, as added
+// by Graph.
+func (v *VertexOf[K, V, W]) InNeighbors() []*VertexOf[K, V, W] {
+	var vertices []*VertexOf[K, V, W]
+	for _, e := range v.in {
+		vertices = append(vertices, e.Other(v))
+	}
+	return vertices
+}
+
+// InDegree retrieves the number of incoming edges Vertex has.
+func (v *VertexOf[K, V, W]) InDegree() int {
+	return len(v.in)
+}
+
+// OutDegree retrieves the number of outgoing edges Vertex has. Same as
+// GetDegree.
+func (v *VertexOf[K, V, W]) OutDegree() int {
 	return len(v.edges)
 }
 
 // HasEdge reports whetver Vertex has an edge given.
-func (v *Vertex) HasEdge(e *Edge) bool {
+func (v *VertexOf[K, V, W]) HasEdge(e *EdgeOf[K, V, W]) bool {
+	if v.edgeSet != nil {
+		_, ok := v.edgeSet[e]
+		return ok
+	}
 	for _, edge := range v.edges {
 		if edge == e {
 			return true
@@ -85,9 +210,12 @@ func (v *Vertex) HasEdge(e *Edge) bool {
 }
 
 // HasNeighbor reports whetver given Vertex is it's neighbor.
-func (v *Vertex) HasNeighbor(w *Vertex) bool {
+func (v *VertexOf[K, V, W]) HasNeighbor(w *VertexOf[K, V, W]) bool {
+	if v.neighbors != nil {
+		return v.neighbors[w] > 0
+	}
 	for _, e := range v.edges {
-		if e.start == w || e.end == w {
+		if v.neighbor(e) == w {
 			return true
 		}
 	}
@@ -95,9 +223,12 @@ func (v *Vertex) HasNeighbor(w *Vertex) bool {
 }
 
 // FindEdge retrieves an edge which connects with Vertex given.
-func (v *Vertex) FindEdge(w *Vertex) *Edge {
+func (v *VertexOf[K, V, W]) FindEdge(w *VertexOf[K, V, W]) *EdgeOf[K, V, W] {
+	if !v.HasNeighbor(w) {
+		return nil
+	}
 	for _, e := range v.edges {
-		if e.start == w || e.end == w {
+		if v.neighbor(e) == w {
 			return e
 		}
 	}
@@ -105,11 +236,13 @@ func (v *Vertex) FindEdge(w *Vertex) *Edge {
 }
 
 // DeleteAllEdges deletes all Vertex edges.
-func (v *Vertex) DeleteAllEdges() {
+func (v *VertexOf[K, V, W]) DeleteAllEdges() {
 	v.edges = nil
+	v.edgeSet = nil
+	v.neighbors = nil
 }
 
 // String retrieves a string representation of the Vertex.
-func (v *Vertex) String() string {
-	return strconv.Itoa(v.Value)
+func (v *VertexOf[K, V, W]) String() string {
+	return fmt.Sprint(v.Value)
 }
@@ -150,6 +150,36 @@ func TestVertex(t *testing.T) {
 		assert.Nil(t, v0.FindEdge(v2))
 	})
 
+	t.Run("should keep lookups of vertex with many edges", func(t *testing.T) {
+		hub := NewVertex(0)
+		v := make([]*Vertex, 2*indexDegree)
+		e := make([]*Edge, len(v))
+		for i := range v {
+			v[i] = NewVertex(i + 1)
+			e[i] = NewEdge(hub, v[i], 0)
+			assert.NoError(t, hub.AddEdges(e[i]))
+		}
+		parallel := NewEdge(hub, v[0], 1)
+		assert.NoError(t, hub.AddEdges(parallel))
+		assert.ErrorIs(t, hub.AddEdges(e[1]), ErrExists)
+
+		assert.NoError(t, hub.DeleteEdge(e[0]))
+		assert.ErrorIs(t, hub.DeleteEdge(e[0]), ErrNotExists)
+		assert.False(t, hub.HasEdge(e[0]))
+		assert.True(t, hub.HasNeighbor(v[0]))
+		assert.Equal(t, parallel, hub.FindEdge(v[0]))
+
+		assert.NoError(t, hub.DeleteEdge(parallel))
+		assert.False(t, hub.HasNeighbor(v[0]))
+		assert.Nil(t, hub.FindEdge(v[0]))
+		assert.True(t, hub.HasEdge(e[len(e)-1]))
+		assert.Equal(t, e[len(e)-1], hub.FindEdge(v[len(v)-1]))
+
+		hub.DeleteAllEdges()
+		assert.False(t, hub.HasNeighbor(v[1]))
+		assert.NoError(t, hub.AddEdges(e[1]))
+	})
+
 	t.Run("should calculate vertex degree", func(t *testing.T) {
 		v0 := NewVertex(0)
 		v1 := NewVertex(1)
@@ -171,4 +201,17 @@ func TestVertex(t *testing.T) {
 
 		assert.Len(t, v0.GetEdges(), 3)
 	})
+
+	t.Run("should not track incoming edges outside of graph", func(t *testing.T) {
+		v0 := NewVertex(0)
+		v1 := NewVertex(1)
+
+		e01 := NewEdge(v0, v1, 0)
+		assert.NoError(t, v0.AddEdges(e01))
+
+		assert.Equal(t, 1, v0.OutDegree())
+		assert.Equal(t, 0, v0.InDegree())
+		assert.Equal(t, 0, v1.InDegree())
+		assert.Empty(t, v1.InNeighbors())
+	})
 }
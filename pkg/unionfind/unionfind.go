@@ -0,0 +1,70 @@
+// Package unionfind implements a disjoint-set data structure over the integer
+// elements 0..n-1.
+package unionfind
+
+// UnionFind represents a partition of elements into disjoint sets.
+//
+// Uses path compression and union by rank, making operations run in
+// near-constant amortized time.
+type UnionFind struct {
+	parent []int
+	rank   []int
+	count  int
+}
+
+// New creates a new UnionFind of n elements, each in its own set.
+func New(n int) *UnionFind {
+	uf := &UnionFind{
+		parent: make([]int, n),
+		rank:   make([]int, n),
+		count:  n,
+	}
+	for i := range uf.parent {
+		uf.parent[i] = i
+	}
+	return uf
+}
+
+// Find retrieves the representative element of the set containing x.
+func (uf *UnionFind) Find(x int) int {
+	root := x
+	for uf.parent[root] != root {
+		root = uf.parent[root]
+	}
+	for uf.parent[x] != root { // Path compression
+		uf.parent[x], x = root, uf.parent[x]
+	}
+	return root
+}
+
+// Union merges sets containing x and y, reporting whether they were disjoint.
+func (uf *UnionFind) Union(x, y int) bool {
+	x, y = uf.Find(x), uf.Find(y)
+	if x == y {
+		return false
+	}
+	if uf.rank[x] < uf.rank[y] {
+		x, y = y, x
+	}
+	uf.parent[y] = x
+	if uf.rank[x] == uf.rank[y] {
+		uf.rank[x]++
+	}
+	uf.count--
+	return true
+}
+
+// Connected reports whether x and y are in the same set.
+func (uf *UnionFind) Connected(x, y int) bool {
+	return uf.Find(x) == uf.Find(y)
+}
+
+// Len retrieves the number of elements.
+func (uf *UnionFind) Len() int {
+	return len(uf.parent)
+}
+
+// Count retrieves the number of disjoint sets.
+func (uf *UnionFind) Count() int {
+	return uf.count
+}
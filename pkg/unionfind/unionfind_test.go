@@ -0,0 +1,59 @@
+package unionfind
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnionFind(t *testing.T) {
+	t.Run("should create disjoint sets", func(t *testing.T) {
+		uf := New(3)
+
+		assert.Equal(t, 3, uf.Len())
+		assert.Equal(t, 3, uf.Count())
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, i, uf.Find(i))
+		}
+		assert.False(t, uf.Connected(0, 1))
+	})
+
+	t.Run("should merge sets", func(t *testing.T) {
+		uf := New(5)
+
+		assert.True(t, uf.Union(0, 1))
+		assert.True(t, uf.Union(3, 4))
+		assert.True(t, uf.Union(1, 4))
+		assert.False(t, uf.Union(0, 3))
+
+		assert.Equal(t, 2, uf.Count())
+		assert.True(t, uf.Connected(0, 3))
+		assert.True(t, uf.Connected(1, 4))
+		assert.False(t, uf.Connected(2, 0))
+		assert.Equal(t, uf.Find(0), uf.Find(4))
+	})
+
+	t.Run("should compress paths", func(t *testing.T) {
+		uf := New(4)
+		uf.parent = []int{0, 0, 1, 2} // Chain 3 -> 2 -> 1 -> 0
+
+		assert.Equal(t, 0, uf.Find(3))
+		assert.Equal(t, []int{0, 0, 0, 0}, uf.parent)
+	})
+
+	t.Run("should attach lower rank tree under higher rank one", func(t *testing.T) {
+		uf := New(3)
+
+		assert.True(t, uf.Union(0, 1))
+		root := uf.Find(0)
+		assert.True(t, uf.Union(2, 0))
+		assert.Equal(t, root, uf.Find(2))
+	})
+
+	t.Run("should handle empty set", func(t *testing.T) {
+		uf := New(0)
+
+		assert.Equal(t, 0, uf.Len())
+		assert.Equal(t, 0, uf.Count())
+	})
+}